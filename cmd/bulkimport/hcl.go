@@ -0,0 +1,201 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// renderResource writes a resource block with the arguments of the read state
+// that can be set in configuration.
+func renderResource(w *bytes.Buffer, typ, name, provider string, r *schema.Resource, d *schema.ResourceData) error {
+	values := make(map[string]interface{}, len(r.Schema))
+	for k := range r.Schema {
+		values[k] = d.Get(k)
+	}
+
+	fmt.Fprintf(w, "resource %q %q {\n", typ, name)
+	if provider != "" {
+		fmt.Fprintf(w, "  provider = %q\n\n", provider)
+	}
+	if err := renderFields(w, 1, r.Schema, values); err != nil {
+		return err
+	}
+	w.WriteString("}\n\n")
+	return nil
+}
+
+func renderFields(w *bytes.Buffer, depth int, s map[string]*schema.Schema, values map[string]interface{}) error {
+	for _, k := range sortedKeys(s) {
+		field := s[k]
+		if !isConfigurable(field) {
+			continue
+		}
+
+		v := normalizeValue(values[k])
+		if !shouldRender(field, v) {
+			continue
+		}
+
+		if err := renderField(w, depth, k, field, v); err != nil {
+			return fmt.Errorf("%s: %s", k, err)
+		}
+	}
+	return nil
+}
+
+func renderField(w *bytes.Buffer, depth int, k string, field *schema.Schema, v interface{}) error {
+	indent := strings.Repeat("  ", depth)
+
+	switch field.Type {
+	case schema.TypeList, schema.TypeSet:
+		items, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected a list, got %T", v)
+		}
+
+		if elem, ok := field.Elem.(*schema.Resource); ok {
+			for _, item := range items {
+				m, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				fmt.Fprintf(w, "%s%s {\n", indent, k)
+				if err := renderFields(w, depth+1, elem.Schema, m); err != nil {
+					return err
+				}
+				fmt.Fprintf(w, "%s}\n", indent)
+			}
+			return nil
+		}
+
+		rendered := make([]string, 0, len(items))
+		for _, item := range items {
+			s, err := renderPrimitive(item)
+			if err != nil {
+				return err
+			}
+			rendered = append(rendered, s)
+		}
+		// Sets have no meaningful order, so sort them for stable output.
+		if field.Type == schema.TypeSet {
+			sort.Strings(rendered)
+		}
+		fmt.Fprintf(w, "%s%s = [%s]\n", indent, k, strings.Join(rendered, ", "))
+	case schema.TypeMap:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected a map, got %T", v)
+		}
+
+		keys := make([]string, 0, len(m))
+		for mk := range m {
+			keys = append(keys, mk)
+		}
+		sort.Strings(keys)
+
+		fmt.Fprintf(w, "%s%s = {\n", indent, k)
+		for _, mk := range keys {
+			s, err := renderPrimitive(m[mk])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%s  %s = %s\n", indent, hclString(mk), s)
+		}
+		fmt.Fprintf(w, "%s}\n", indent)
+	default:
+		s, err := renderPrimitive(v)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s%s = %s\n", indent, k, s)
+	}
+
+	return nil
+}
+
+func renderPrimitive(v interface{}) (string, error) {
+	switch t := v.(type) {
+	case string:
+		return hclString(t), nil
+	case bool:
+		return strconv.FormatBool(t), nil
+	case int:
+		return strconv.Itoa(t), nil
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64), nil
+	}
+	return "", fmt.Errorf("unable to render a value of type %T", v)
+}
+
+// hclString quotes a string, escaping interpolation sequences so the value is
+// used as-is.
+func hclString(s string) string {
+	return strings.Replace(strconv.Quote(s), "${", "$${", -1)
+}
+
+// Only arguments are rendered: not computed-only attributes, and not fields
+// that are removed or deprecated.
+func isConfigurable(field *schema.Schema) bool {
+	return (field.Required || field.Optional) && field.Removed == "" && field.Deprecated == ""
+}
+
+// shouldRender leaves out optional arguments that have their default or zero
+// value, to keep the generated configuration close to what a user would write.
+func shouldRender(field *schema.Schema, v interface{}) bool {
+	if field.Required {
+		return true
+	}
+	if field.Default != nil {
+		return !reflect.DeepEqual(v, field.Default)
+	}
+	return !isZero(v)
+}
+
+func isZero(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	switch t := v.(type) {
+	case []interface{}:
+		return len(t) == 0
+	case map[string]interface{}:
+		return len(t) == 0
+	}
+	return reflect.DeepEqual(v, reflect.Zero(reflect.TypeOf(v)).Interface())
+}
+
+// normalizeValue converts sets, including those nested in blocks, to lists.
+func normalizeValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case *schema.Set:
+		return normalizeValue(t.List())
+	case []interface{}:
+		l := make([]interface{}, len(t))
+		for i, item := range t {
+			l[i] = normalizeValue(item)
+		}
+		return l
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, item := range t {
+			m[k] = normalizeValue(item)
+		}
+		return m
+	}
+	return v
+}
+
+func sortedKeys(m map[string]*schema.Schema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestRenderResource(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"script": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"self_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"old_field": {
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Use name instead.",
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":      "my-resource",
+		"enabled":   false,
+		"labels":    map[string]interface{}{"env": "prod"},
+		"tags":      []interface{}{"b", "a"},
+		"config":    []interface{}{map[string]interface{}{"script": "echo ${HOME}"}},
+		"self_link": "https://example.com/my-resource",
+		"old_field": "old",
+	})
+
+	buf := &bytes.Buffer{}
+	if err := renderResource(buf, "google_thing", "my-resource", "google-beta", r, d); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `resource "google_thing" "my-resource" {
+  provider = "google-beta"
+
+  config {
+    script = "echo $${HOME}"
+  }
+  enabled = false
+  labels = {
+    "env" = "prod"
+  }
+  name = "my-resource"
+  tags = ["a", "b"]
+}
+
+`
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, buf.String())
+	}
+}
+
+func TestSanitizeResourceName(t *testing.T) {
+	cases := map[string]string{
+		"my-network":       "my-network",
+		"My.Bucket.Name":   "my_bucket_name",
+		"123-instance":     "r_123-instance",
+		"roles/owner":      "roles_owner",
+		"sa@p.example.com": "sa_p_example_com",
+	}
+
+	for name, expected := range cases {
+		if got := sanitizeResourceName(name); got != expected {
+			t.Errorf("sanitizeResourceName(%q) = %q, expected %q", name, got, expected)
+		}
+	}
+}
+
+func TestUniqueResourceName(t *testing.T) {
+	used := make(map[string]bool)
+	for _, expected := range []string{"web", "web_2", "web_3"} {
+		if got := uniqueResourceName("google_compute_instance", "web", used); got != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	}
+
+	if got := uniqueResourceName("google_compute_disk", "web", used); got != "web" {
+		t.Errorf("Expected names to only need to be unique per type, got %q", got)
+	}
+}
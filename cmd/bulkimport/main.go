@@ -0,0 +1,178 @@
+// Generates Terraform configuration and import commands for the resources that
+// already exist in a project, so it can be brought under management.
+//
+// Resources are found with the provider's own API clients, imported with each
+// resource's importer and read with its Read function, so the generated
+// configuration matches what the provider would store in state. It's a starting
+// point: review it, and check that `terraform plan` shows no changes after
+// running the import commands.
+//
+// Credentials are read the same way as the provider reads them, from the
+// -credentials flag or the GOOGLE_CREDENTIALS family of environment variables,
+// falling back to application default credentials.
+//
+// Usage example (from root dir):
+//
+//	go run ./cmd/bulkimport -project my-project -region us-central1 -types google_compute_network,google_compute_subnetwork
+//
+// This writes `imported.tf` and `import.sh` to the directory given by -output-dir.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-google-beta/google-beta"
+)
+
+func main() {
+	project := flag.String("project", "", "project to import resources from")
+	region := flag.String("region", "", "default region for the provider")
+	zone := flag.String("zone", "", "default zone for the provider")
+	credentials := flag.String("credentials", "", "path to or contents of a service account key file")
+	types := flag.String("types", "", fmt.Sprintf("comma-separated resource types to import, from %s. Defaults to all of them", strings.Join(google.ImportableResourceTypes(), ", ")))
+	provider := flag.String("provider", "google-beta", "provider the generated resources use")
+	outputDir := flag.String("output-dir", ".", "directory to write imported.tf and import.sh to")
+	flag.Parse()
+
+	if *project == "" {
+		flag.PrintDefaults()
+		log.Fatal("usage: go run ./cmd/bulkimport -project $PROJECT [-region $REGION] [-types $TYPES]")
+	}
+
+	p := google.Provider().(*schema.Provider)
+	if err := configureProvider(p, *project, *region, *zone, *credentials); err != nil {
+		log.Fatal(err)
+	}
+
+	var typeList []string
+	if *types != "" {
+		typeList = strings.Split(*types, ",")
+	}
+
+	found, err := p.Meta().(*google.Config).ListImportableResources(typeList)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("[INFO] Found %d resources to import", len(found))
+
+	hcl := &bytes.Buffer{}
+	script := &bytes.Buffer{}
+	script.WriteString("#!/bin/sh\nset -e\n\n")
+
+	names := make(map[string]bool)
+	for _, res := range found {
+		r := p.ResourcesMap[res.Type]
+		state, err := readImportedState(p, res)
+		if err != nil {
+			log.Printf("[WARN] Skipping %s %q: %s", res.Type, res.ImportId, err)
+			continue
+		}
+
+		name := uniqueResourceName(res.Type, res.Name, names)
+		address := fmt.Sprintf("%s.%s", res.Type, name)
+		fmt.Fprintf(script, "terraform import %s %s\n", address, shellQuote(res.ImportId))
+
+		if err := renderResource(hcl, res.Type, name, *provider, r, r.Data(state)); err != nil {
+			log.Printf("[WARN] Unable to render configuration for %s: %s", address, err)
+		}
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(*outputDir, "imported.tf"), hcl.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(*outputDir, "import.sh"), script.Bytes(), 0755); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func configureProvider(p *schema.Provider, project, region, zone, credentials string) error {
+	raw := map[string]interface{}{
+		"project": project,
+	}
+	if region != "" {
+		raw["region"] = region
+	}
+	if zone != "" {
+		raw["zone"] = zone
+	}
+	if credentials != "" {
+		raw["credentials"] = credentials
+	}
+
+	rc, err := config.NewRawConfig(raw)
+	if err != nil {
+		return err
+	}
+	return p.Configure(terraform.NewResourceConfig(rc))
+}
+
+// readImportedState runs the resource's importer and then its Read, the same
+// steps `terraform import` takes.
+func readImportedState(p *schema.Provider, res google.ImportableResource) (*terraform.InstanceState, error) {
+	info := &terraform.InstanceInfo{
+		Id:   res.ImportId,
+		Type: res.Type,
+	}
+
+	states, err := p.ImportState(info, res.ImportId)
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 {
+		return nil, fmt.Errorf("importer returned no state")
+	}
+
+	// Importers may return additional states for related resources; the first
+	// is always the resource itself.
+	state, err := p.Refresh(info, states[0])
+	if err != nil {
+		return nil, err
+	}
+	if state == nil || state.ID == "" {
+		return nil, fmt.Errorf("resource no longer exists")
+	}
+	return state, nil
+}
+
+// uniqueResourceName turns a resource's name into a valid, unique resource name.
+func uniqueResourceName(typ, name string, used map[string]bool) string {
+	name = sanitizeResourceName(name)
+
+	candidate := name
+	for i := 2; used[typ+"."+candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+	used[typ+"."+candidate] = true
+	return candidate
+}
+
+func sanitizeResourceName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '-' {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+
+	sanitized := b.String()
+	if sanitized == "" || (sanitized[0] >= '0' && sanitized[0] <= '9') || sanitized[0] == '-' {
+		sanitized = "r_" + sanitized
+	}
+	return sanitized
+}
+
+// shellQuote quotes an import id, since some contain spaces.
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
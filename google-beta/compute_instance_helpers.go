@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	computeBeta "google.golang.org/api/compute/v0.beta"
 )
 
@@ -39,10 +40,69 @@ func flattenScheduling(scheduling *computeBeta.Scheduling) []map[string]interfac
 	if scheduling.AutomaticRestart != nil {
 		schedulingMap["automatic_restart"] = *scheduling.AutomaticRestart
 	}
+	schedulingMap["node_affinities"] = flattenSchedulingNodeAffinities(scheduling.NodeAffinities)
 	result = append(result, schedulingMap)
 	return result
 }
 
+// schedulingNodeAffinitiesElemSchema is the schema of a scheduling block's
+// node_affinities, shared by instances and instance templates.
+func schedulingNodeAffinitiesElemSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"operator": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"IN", "NOT_IN"}, false),
+			},
+
+			"values": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func expandSchedulingNodeAffinities(affinities *schema.Set) []*computeBeta.SchedulingNodeAffinity {
+	if affinities == nil {
+		return nil
+	}
+
+	result := make([]*computeBeta.SchedulingNodeAffinity, 0, affinities.Len())
+	for _, raw := range affinities.List() {
+		data := raw.(map[string]interface{})
+		result = append(result, &computeBeta.SchedulingNodeAffinity{
+			Key:      data["key"].(string),
+			Operator: data["operator"].(string),
+			Values:   convertStringSet(data["values"].(*schema.Set)),
+		})
+	}
+	return result
+}
+
+func flattenSchedulingNodeAffinities(affinities []*computeBeta.SchedulingNodeAffinity) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(affinities))
+	for _, affinity := range affinities {
+		result = append(result, map[string]interface{}{
+			"key":      affinity.Key,
+			"operator": affinity.Operator,
+			"values":   schema.NewSet(schema.HashString, convertStringArrToInterface(affinity.Values)),
+		})
+	}
+	return result
+}
+
 func flattenAccessConfigs(accessConfigs []*computeBeta.AccessConfig) ([]map[string]interface{}, string) {
 	flattened := make([]map[string]interface{}, len(accessConfigs))
 	natIP := ""
@@ -0,0 +1,246 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+// When the provider's quota_check argument is set, resources that consume
+// regional compute quota check the quota they'd use during plan. Quota is a
+// property of the region, not the resource, so the planned use of every
+// resource in the plan is summed: a rollout of many instances is checked as a
+// whole rather than one at a time.
+//
+// Exceeding quota isn't always an error (usage changes, quota can be raised
+// before apply), so by default ("warn") it's only logged; "error" fails the
+// plan instead. Either way a quota that can't be read doesn't stop the plan.
+
+const (
+	computeQuotaCheckWarn  = "warn"
+	computeQuotaCheckError = "error"
+)
+
+// computeQuotaDemandFunc returns the region a change to a resource is in and the
+// quota it would use, by metric. It returns an empty region if it's not known
+// yet, or if the change uses no quota.
+type computeQuotaDemandFunc func(diff *schema.ResourceDiff, config *Config) (project, region string, demand map[string]float64, err error)
+
+type computeQuotaTracker struct {
+	mu sync.Mutex
+	// Both keyed by "project/region" and then by metric.
+	quotas  map[string]map[string]*compute.Quota
+	planned map[string]map[string]float64
+}
+
+func newComputeQuotaTracker() *computeQuotaTracker {
+	return &computeQuotaTracker{
+		quotas:  make(map[string]map[string]*compute.Quota),
+		planned: make(map[string]map[string]float64),
+	}
+}
+
+// plan adds demand to the quota planned in the region, and returns a warning for
+// each metric that would then go over its limit.
+func (t *computeQuotaTracker) plan(config *Config, project, region string, demand map[string]float64) ([]string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := project + "/" + region
+	if _, ok := t.quotas[key]; !ok {
+		r, err := config.clientCompute.Regions.Get(project, region).Do()
+		if err != nil {
+			return nil, err
+		}
+		t.setQuotas(key, r.Quotas)
+	}
+
+	return t.reserve(project, region, demand), nil
+}
+
+func (t *computeQuotaTracker) setQuotas(key string, quotas []*compute.Quota) {
+	byMetric := make(map[string]*compute.Quota, len(quotas))
+	for _, q := range quotas {
+		byMetric[q.Metric] = q
+	}
+	t.quotas[key] = byMetric
+}
+
+func (t *computeQuotaTracker) reserve(project, region string, demand map[string]float64) []string {
+	key := project + "/" + region
+	if _, ok := t.planned[key]; !ok {
+		t.planned[key] = make(map[string]float64)
+	}
+
+	metrics := make([]string, 0, len(demand))
+	for m := range demand {
+		metrics = append(metrics, m)
+	}
+	sort.Strings(metrics)
+
+	var warnings []string
+	for _, m := range metrics {
+		t.planned[key][m] += demand[m]
+
+		q, ok := t.quotas[key][m]
+		if !ok {
+			continue
+		}
+		if planned := t.planned[key][m]; q.Usage+planned > q.Limit {
+			warnings = append(warnings, fmt.Sprintf("The plan would exceed the %s quota in region %q of project %q: %g in use, %g planned, limit %g", m, region, project, q.Usage, planned, q.Limit))
+		}
+	}
+	return warnings
+}
+
+// computeQuotaCheckCustomizeDiff returns a CustomizeDiffFunc that checks the
+// quota a resource would use when quota checks are enabled.
+func computeQuotaCheckCustomizeDiff(demandFunc computeQuotaDemandFunc) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		config, ok := meta.(*Config)
+		if !ok || config.QuotaCheck == "" {
+			return nil
+		}
+
+		project, region, demand, err := demandFunc(diff, config)
+		if err != nil {
+			log.Printf("[WARN] Unable to check quota: %s", err)
+			return nil
+		}
+		if region == "" || len(demand) == 0 {
+			return nil
+		}
+
+		warnings, err := config.computeQuotas.plan(config, project, region, demand)
+		if err != nil {
+			log.Printf("[WARN] Unable to read quota for region %q of project %q: %s", region, project, err)
+			return nil
+		}
+		return computeQuotaCheckResult(config.QuotaCheck, warnings)
+	}
+}
+
+// computeQuotaCheckResult fails the plan with the quota that would be exceeded
+// in "error" mode, and only logs it otherwise.
+func computeQuotaCheckResult(mode string, warnings []string) error {
+	if len(warnings) == 0 {
+		return nil
+	}
+	if mode == computeQuotaCheckError {
+		return fmt.Errorf("%s. Set the provider's quota_check to %q to plan anyway.", strings.Join(warnings, "; "), computeQuotaCheckWarn)
+	}
+
+	for _, w := range warnings {
+		log.Printf("[WARN] %s", w)
+	}
+	return nil
+}
+
+func computeInstanceQuotaDemand(diff *schema.ResourceDiff, config *Config) (string, string, map[string]float64, error) {
+	// Only new instances use more quota.
+	if diff.Id() != "" {
+		return "", "", nil, nil
+	}
+
+	project, err := getProjectFromDiff(diff, config)
+	if err != nil {
+		return "", "", nil, err
+	}
+	zone := config.Zone
+	if v, ok := diff.GetOk("zone"); ok {
+		zone = v.(string)
+	} else if !diff.NewValueKnown("zone") {
+		return "", "", nil, nil
+	}
+	if zone == "" {
+		return "", "", nil, nil
+	}
+
+	demand := map[string]float64{
+		"INSTANCES": 1,
+	}
+	if diff.NewValueKnown("machine_type") {
+		mt := GetResourceNameFromSelfLink(diff.Get("machine_type").(string))
+		machineType, err := config.clientCompute.MachineTypes.Get(project, zone, mt).Do()
+		if err != nil {
+			log.Printf("[WARN] Unable to load machine type %q to check CPU quota: %s", mt, err)
+		} else if diff.Get("scheduling.0.preemptible").(bool) {
+			demand["PREEMPTIBLE_CPUS"] = float64(machineType.GuestCpus)
+		} else {
+			demand["CPUS"] = float64(machineType.GuestCpus)
+		}
+	}
+
+	return project, getRegionFromZone(zone), demand, nil
+}
+
+// An instance group manager uses instance quota as it's resized. The CPUs used
+// depend on its instance template, so only instances are counted.
+func instanceGroupManagerQuotaDemand(diff *schema.ResourceDiff, config *Config) (string, string, map[string]float64, error) {
+	added := instanceGroupManagerAddedInstances(diff)
+	if added <= 0 {
+		return "", "", nil, nil
+	}
+
+	project, err := getProjectFromDiff(diff, config)
+	if err != nil {
+		return "", "", nil, err
+	}
+	zone := config.Zone
+	if v, ok := diff.GetOk("zone"); ok {
+		zone = v.(string)
+	} else if !diff.NewValueKnown("zone") {
+		return "", "", nil, nil
+	}
+
+	return project, getRegionFromZone(zone), map[string]float64{"INSTANCES": float64(added)}, nil
+}
+
+func regionInstanceGroupManagerQuotaDemand(diff *schema.ResourceDiff, config *Config) (string, string, map[string]float64, error) {
+	added := instanceGroupManagerAddedInstances(diff)
+	if added <= 0 || !diff.NewValueKnown("region") {
+		return "", "", nil, nil
+	}
+
+	project, err := getProjectFromDiff(diff, config)
+	if err != nil {
+		return "", "", nil, err
+	}
+	region := GetResourceNameFromSelfLink(diff.Get("region").(string))
+
+	return project, region, map[string]float64{"INSTANCES": float64(added)}, nil
+}
+
+func instanceGroupManagerAddedInstances(diff *schema.ResourceDiff) int {
+	o, n := diff.GetChange("target_size")
+	return n.(int) - o.(int)
+}
+
+func computeAddressQuotaDemand(diff *schema.ResourceDiff, config *Config) (string, string, map[string]float64, error) {
+	if diff.Id() != "" {
+		return "", "", nil, nil
+	}
+
+	project, err := getProjectFromDiff(diff, config)
+	if err != nil {
+		return "", "", nil, err
+	}
+	region := config.Region
+	if v, ok := diff.GetOk("region"); ok {
+		region = GetResourceNameFromSelfLink(v.(string))
+	} else if !diff.NewValueKnown("region") {
+		return "", "", nil, nil
+	}
+
+	metric := "STATIC_ADDRESSES"
+	if diff.Get("address_type").(string) == "INTERNAL" {
+		metric = "INTERNAL_ADDRESSES"
+	}
+
+	return project, region, map[string]float64{metric: 1}, nil
+}
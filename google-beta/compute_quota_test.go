@@ -0,0 +1,88 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/api/compute/v1"
+)
+
+func TestComputeQuotaTrackerReserve(t *testing.T) {
+	tracker := newComputeQuotaTracker()
+	tracker.setQuotas("my-project/us-central1", []*compute.Quota{
+		{Metric: "CPUS", Limit: 24, Usage: 16},
+		{Metric: "INSTANCES", Limit: 10, Usage: 2},
+	})
+
+	steps := []struct {
+		Project  string
+		Region   string
+		Demand   map[string]float64
+		Expected []string
+	}{
+		{
+			Project: "my-project",
+			Region:  "us-central1",
+			Demand:  map[string]float64{"CPUS": 4, "INSTANCES": 1},
+		},
+		{
+			Project: "my-project",
+			Region:  "us-central1",
+			Demand:  map[string]float64{"CPUS": 4, "INSTANCES": 1},
+		},
+		{
+			// The CPUs planned by earlier resources count towards the quota.
+			Project: "my-project",
+			Region:  "us-central1",
+			Demand:  map[string]float64{"CPUS": 4, "INSTANCES": 1},
+			Expected: []string{
+				`The plan would exceed the CPUS quota in region "us-central1" of project "my-project": 16 in use, 12 planned, limit 24`,
+			},
+		},
+		{
+			// Metrics without a quota are ignored.
+			Project: "my-project",
+			Region:  "us-central1",
+			Demand:  map[string]float64{"STATIC_ADDRESSES": 100},
+		},
+	}
+
+	for i, step := range steps {
+		warnings := tracker.reserve(step.Project, step.Region, step.Demand)
+		if !reflect.DeepEqual(warnings, step.Expected) {
+			t.Errorf("bad: step %d, expected warnings %q, got %q", i, step.Expected, warnings)
+		}
+	}
+}
+
+func TestComputeQuotaCheckResult(t *testing.T) {
+	warnings := []string{
+		`The plan would exceed the CPUS quota in region "us-central1" of project "my-project": 16 in use, 12 planned, limit 24`,
+	}
+
+	cases := map[string]struct {
+		Mode          string
+		Warnings      []string
+		ExpectedError bool
+	}{
+		"warn": {
+			Mode:     "warn",
+			Warnings: warnings,
+		},
+		"error": {
+			Mode:          "error",
+			Warnings:      warnings,
+			ExpectedError: true,
+		},
+		"error within quota": {
+			Mode: "error",
+		},
+	}
+
+	for tn, tc := range cases {
+		err := computeQuotaCheckResult(tc.Mode, tc.Warnings)
+		if tc.ExpectedError != (err != nil) {
+			t.Errorf("bad: %s, expected error: %t, got %v", tn, tc.ExpectedError, err)
+		}
+	}
+}
@@ -3,9 +3,11 @@ package google
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/logging"
@@ -19,6 +21,8 @@ import (
 	"google.golang.org/api/accesscontextmanager/v1beta"
 	appengine "google.golang.org/api/appengine/v1"
 	"google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/billingbudgets/v1beta1"
+	"google.golang.org/api/cloudasset/v1"
 	"google.golang.org/api/cloudbilling/v1"
 	"google.golang.org/api/cloudbuild/v1"
 	"google.golang.org/api/cloudfunctions/v1"
@@ -58,6 +62,12 @@ type Config struct {
 	Region      string
 	Zone        string
 
+	// QuotaCheck is how regional compute quota is checked during plan: "warn"
+	// logs quota the plan would exceed and "error" fails the plan. Quota isn't
+	// checked when it's empty.
+	QuotaCheck    string
+	computeQuotas *computeQuotaTracker
+
 	client    *http.Client
 	userAgent string
 
@@ -65,6 +75,8 @@ type Config struct {
 
 	clientAccessContextManager   *accesscontextmanager.Service
 	clientBilling                *cloudbilling.APIService
+	clientBillingBudgets         *billingbudgets.Service
+	clientCloudAsset             *cloudasset.Service
 	clientBuild                  *cloudbuild.Service
 	clientComposer               *composer.Service
 	clientCompute                *compute.Service
@@ -106,6 +118,7 @@ func (c *Config) loadAndValidate() error {
 		"https://www.googleapis.com/auth/cloud-platform",
 		"https://www.googleapis.com/auth/ndev.clouddns.readwrite",
 		"https://www.googleapis.com/auth/devstorage.full_control",
+		"https://www.googleapis.com/auth/userinfo.email",
 	}
 
 	var client *http.Client
@@ -309,6 +322,20 @@ func (c *Config) loadAndValidate() error {
 	}
 	c.clientBilling.UserAgent = userAgent
 
+	log.Printf("[INFO] Instantiating Google Cloud Billing Budgets Client...")
+	c.clientBillingBudgets, err = billingbudgets.New(client)
+	if err != nil {
+		return err
+	}
+	c.clientBillingBudgets.UserAgent = userAgent
+
+	log.Printf("[INFO] Instantiating Google Cloud Asset Client...")
+	c.clientCloudAsset, err = cloudasset.New(client)
+	if err != nil {
+		return err
+	}
+	c.clientCloudAsset.UserAgent = userAgent
+
 	log.Printf("[INFO] Instantiating Google Cloud Build Client...")
 	c.clientBuild, err = cloudbuild.New(client)
 	if err != nil {
@@ -400,6 +427,46 @@ func (c *Config) loadAndValidate() error {
 	return nil
 }
 
+// errCallerIdentityNoEmail is returned by getCallerIdentity when the token is
+// valid but its info doesn't include an email address.
+var errCallerIdentityNoEmail = errors.New("Token info did not include an email address; the credentials may lack the userinfo.email scope")
+
+// getCallerIdentity returns the email address of the account the provider is
+// authenticated as, as reported by the OAuth2 tokeninfo endpoint for a token
+// minted by the configured token source.
+func (c *Config) getCallerIdentity() (string, error) {
+	token, err := c.tokenSource.Token()
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving access token: %s", err)
+	}
+
+	// The token is posted in the request body rather than the URL, using a
+	// client without the debug logging transport so it isn't logged either.
+	resp, err := http.PostForm("https://www.googleapis.com/oauth2/v3/tokeninfo", url.Values{
+		"access_token": {token.AccessToken},
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving token info: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Error retrieving token info: unexpected status %s", resp.Status)
+	}
+
+	var info struct {
+		Email string `json:"email"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return "", fmt.Errorf("Error parsing token info: %s", err)
+	}
+	if info.Email == "" {
+		return "", errCallerIdentityNoEmail
+	}
+
+	return info.Email, nil
+}
+
 // accountFile represents the structure of the account file JSON file.
 type accountFile struct {
 	PrivateKeyId string `json:"private_key_id"`
@@ -0,0 +1,127 @@
+package google
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleComputeCdnSignedUrl() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeCdnSignedUrlRead,
+
+		Schema: map[string]*schema.Schema{
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key_value": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"duration": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "1h",
+			},
+			"signed_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeCdnSignedUrlRead(d *schema.ResourceData, meta interface{}) error {
+	// Build CdnUrlData object from data source attributes
+	urlData := &CdnUrlData{
+		Url:     d.Get("url").(string),
+		KeyName: d.Get("key_name").(string),
+	}
+
+	// The key is generated by `head -c 16 /dev/urandom | base64 | tr +/ -_`,
+	// the same format the signed URL key resources take.
+	key, err := base64.URLEncoding.DecodeString(d.Get("key_value").(string))
+	if err != nil {
+		return errwrap.Wrapf("could not decode key_value, it must be a base64url encoded string: {{err}}", err)
+	}
+	urlData.Key = key
+
+	// convert duration to an expiration datetime (unix time in seconds)
+	duration, err := time.ParseDuration(d.Get("duration").(string))
+	if err != nil {
+		return errwrap.Wrapf("could not parse duration: {{err}}", err)
+	}
+	urlData.Expires = int(time.Now().Unix() + int64(duration.Seconds()))
+
+	d.Set("signed_url", urlData.SignedUrl())
+	d.SetId(urlData.EncodedSignature())
+
+	return nil
+}
+
+// CdnUrlData stores the values required to create a Cloud CDN Signed Url
+type CdnUrlData struct {
+	Url     string
+	KeyName string
+	Key     []byte
+	Expires int
+}
+
+// SigningString creates the url that is signed, with the expiry and key name
+// appended as query parameters:
+// see https://cloud.google.com/cdn/docs/using-signed-urls
+// Example output:
+// -------------------
+// https://example.com/path/to/file?Expires=1388534400&KeyName=my-key
+// -------------------
+func (u *CdnUrlData) SigningString() []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString(u.Url)
+	if strings.Contains(u.Url, "?") {
+		buf.WriteString("&")
+	} else {
+		buf.WriteString("?")
+	}
+	buf.WriteString("Expires=")
+	buf.WriteString(strconv.Itoa(u.Expires))
+	buf.WriteString("&KeyName=")
+	buf.WriteString(u.KeyName)
+
+	return buf.Bytes()
+}
+
+// Signature calculates the HMAC-SHA1 signature of the signing string
+func (u *CdnUrlData) Signature() []byte {
+	mac := hmac.New(sha1.New, u.Key)
+	mac.Write(u.SigningString())
+	return mac.Sum(nil)
+}
+
+// EncodedSignature returns the Signature() after base64url encoding
+func (u *CdnUrlData) EncodedSignature() string {
+	return base64.URLEncoding.EncodeToString(u.Signature())
+}
+
+// SignedUrl constructs the final signed URL a client can use to retrieve CDN content
+func (u *CdnUrlData) SignedUrl() string {
+	var urlBuffer bytes.Buffer
+	urlBuffer.Write(u.SigningString())
+	urlBuffer.WriteString("&Signature=")
+	urlBuffer.WriteString(u.EncodedSignature())
+
+	return urlBuffer.String()
+}
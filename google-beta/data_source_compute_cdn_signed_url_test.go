@@ -0,0 +1,76 @@
+package google
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The following values were computed independently with
+// base64url(HMAC-SHA1(key, "<url>?Expires=<expires>&KeyName=<key_name>")).
+const testCdnUrlKey = "nZtRohdNF9m3cKM24IcK4w=="
+const testCdnUrlExpires = 1470967410
+
+func TestCdnUrlData_SignedUrl(t *testing.T) {
+	key, err := base64.URLEncoding.DecodeString(testCdnUrlKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		Url      string
+		Expected string
+	}{
+		"url without query": {
+			Url:      "https://example.com/path/to/file",
+			Expected: "https://example.com/path/to/file?Expires=1470967410&KeyName=my-key&Signature=I1Zjy2dBBqIc_Xd9i3SSqiEK9RU=",
+		},
+		"url with query": {
+			Url:      "https://example.com/path/to/file?quality=hd",
+			Expected: "https://example.com/path/to/file?quality=hd&Expires=1470967410&KeyName=my-key&Signature=Q5koq6boiUJzoT9V-RbjRx55Hx4=",
+		},
+	}
+
+	for tn, tc := range cases {
+		urlData := &CdnUrlData{
+			Url:     tc.Url,
+			KeyName: "my-key",
+			Key:     key,
+			Expires: testCdnUrlExpires,
+		}
+
+		if result := urlData.SignedUrl(); result != tc.Expected {
+			t.Errorf("bad: %s, URL does not match expected value:\n%s\n%s", tn, tc.Expected, result)
+		}
+	}
+}
+
+func TestAccComputeCdnSignedUrl_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeCdnSignedUrl_basic(),
+				Check: resource.TestMatchResourceAttr("data.google_compute_cdn_signed_url.url", "signed_url",
+					regexp.MustCompile(`^https://example.com/path/to/file\?Expires=\d+&KeyName=my-key&Signature=[-_=A-Za-z0-9]+$`)),
+			},
+		},
+	})
+}
+
+func testAccComputeCdnSignedUrl_basic() string {
+	return fmt.Sprintf(`
+data "google_compute_cdn_signed_url" "url" {
+  url       = "https://example.com/path/to/file"
+  key_name  = "my-key"
+  key_value = "%s"
+  duration  = "30m"
+}
+`, testCdnUrlKey)
+}
@@ -0,0 +1,164 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudasset/v1"
+)
+
+func dataSourceGoogleCloudAssetResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleCloudAssetResourcesRead,
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCloudAssetScope,
+			},
+			"asset_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"query": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"asset_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleCloudAssetResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	scope := d.Get("scope").(string)
+	assetTypes := convertStringArr(d.Get("asset_types").([]interface{}))
+	query := cloudAssetSearchQuery(d.Get("query").(string), d.Get("labels").(map[string]interface{}))
+
+	params := url.Values{}
+	for _, t := range assetTypes {
+		params.Add("assetTypes", t)
+	}
+	if query != "" {
+		params.Set("query", query)
+	}
+
+	id := fmt.Sprintf("%s?%s", scope, params.Encode())
+	resources := make([]map[string]interface{}, 0)
+	names := make([]string, 0)
+
+	log.Printf("[DEBUG] Searching assets in %s with %s", scope, params.Encode())
+	call := config.clientCloudAsset.V1.SearchAllResources(scope).AssetTypes(assetTypes...)
+	if query != "" {
+		call = call.Query(query)
+	}
+	err := call.Pages(context.Background(), func(res *cloudasset.SearchAllResourcesResponse) error {
+		for _, r := range res.Results {
+			resources = append(resources, flattenCloudAssetResource(r))
+			names = append(names, r.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error searching assets in %s: %s", scope, err)
+	}
+
+	if err := d.Set("resources", resources); err != nil {
+		return fmt.Errorf("Error setting resources: %s", err)
+	}
+	d.Set("names", names)
+	d.SetId(id)
+
+	return nil
+}
+
+// cloudAssetSearchQuery combines a search query and labels the resources must
+// have into a single query.
+func cloudAssetSearchQuery(query string, labels map[string]interface{}) string {
+	var terms []string
+	if query != "" {
+		terms = append(terms, fmt.Sprintf("(%s)", query))
+	}
+
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		terms = append(terms, fmt.Sprintf("labels.%s=%q", k, labels[k].(string)))
+	}
+
+	return strings.Join(terms, " AND ")
+}
+
+func flattenCloudAssetResource(r *cloudasset.ResourceSearchResult) map[string]interface{} {
+	return map[string]interface{}{
+		"name":         r.Name,
+		"asset_type":   r.AssetType,
+		"project":      r.Project,
+		"display_name": r.DisplayName,
+		"location":     r.Location,
+		"labels":       r.Labels,
+	}
+}
+
+func validateCloudAssetScope(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	for _, prefix := range []string{"projects/", "folders/", "organizations/"} {
+		if strings.HasPrefix(value, prefix) {
+			return
+		}
+	}
+	errors = append(errors, fmt.Errorf("%q must be in the form projects/{project}, folders/{folder_id} or organizations/{organization_id}, got %q", k, value))
+	return
+}
@@ -0,0 +1,69 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestCloudAssetSearchQuery(t *testing.T) {
+	cases := map[string]struct {
+		Query    string
+		Labels   map[string]interface{}
+		Expected string
+	}{
+		"empty": {
+			Expected: "",
+		},
+		"query only": {
+			Query:    "name:foo OR name:bar",
+			Expected: "(name:foo OR name:bar)",
+		},
+		"labels only": {
+			Labels:   map[string]interface{}{"team": "infra", "env": "prod"},
+			Expected: `labels.env="prod" AND labels.team="infra"`,
+		},
+		"query and labels": {
+			Query:    "location:us-central1",
+			Labels:   map[string]interface{}{"env": "prod"},
+			Expected: `(location:us-central1) AND labels.env="prod"`,
+		},
+	}
+
+	for tn, tc := range cases {
+		if query := cloudAssetSearchQuery(tc.Query, tc.Labels); query != tc.Expected {
+			t.Errorf("bad: %s, expected %q, got %q", tn, tc.Expected, query)
+		}
+	}
+}
+
+func TestAccDataSourceGoogleCloudAssetResources_project(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleCloudAssetResourcesConfig(project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_cloud_asset_resources.project", "resources.#", "1"),
+					resource.TestCheckResourceAttr("data.google_cloud_asset_resources.project", "resources.0.asset_type", "cloudresourcemanager.googleapis.com/Project"),
+					resource.TestCheckResourceAttr("data.google_cloud_asset_resources.project", "names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleCloudAssetResourcesConfig(project string) string {
+	return fmt.Sprintf(`
+data "google_cloud_asset_resources" "project" {
+  scope       = "projects/%s"
+  asset_types = ["cloudresourcemanager.googleapis.com/Project"]
+}
+`, project)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
 )
 
 var (
@@ -72,6 +73,12 @@ func dataSourceGoogleComputeAddressRead(d *schema.ResourceData, meta interface{}
 		return handleNotFoundError(err, d, fmt.Sprintf("Address Not Found : %s", name))
 	}
 
+	setComputeAddressDataSourceFields(d, project, region, address)
+	return nil
+}
+
+func setComputeAddressDataSourceFields(d *schema.ResourceData, project, region string, address *compute.Address) {
+	d.Set("name", address.Name)
 	d.Set("address", address.Address)
 	d.Set("status", address.Status)
 	d.Set("self_link", address.SelfLink)
@@ -79,7 +86,6 @@ func dataSourceGoogleComputeAddressRead(d *schema.ResourceData, meta interface{}
 	d.Set("region", region)
 
 	d.SetId(strconv.FormatUint(uint64(address.Id), 10))
-	return nil
 }
 
 type computeAddressId struct {
@@ -0,0 +1,80 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	computeBeta "google.golang.org/api/compute/v0.beta"
+	"google.golang.org/api/compute/v1"
+)
+
+func dataSourceGoogleComputeAddresses() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceGoogleComputeAddressesRead,
+		Schema: computeListDataSourceSchema("addresses", "region", true, computeAddressesItemSchema()),
+	}
+}
+
+// The attributes of each address are those of the google_compute_address data
+// source.
+func computeAddressesItemSchema() map[string]*schema.Schema {
+	return datasourceSchemaFromResourceSchema(dataSourceGoogleComputeAddress().Schema)
+}
+
+func dataSourceGoogleComputeAddressesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	itemSchema := computeAddressesItemSchema()
+	addresses := make([]map[string]interface{}, 0)
+	selfLinks := make([]string, 0)
+
+	filter := computeListFilter(d)
+	log.Printf("[DEBUG] Listing addresses in %s/%s with filter %q", project, region, filter)
+	// Addresses are listed with the beta API, as the GA API can't filter them by
+	// labels.
+	err = config.clientComputeBeta.Addresses.List(project, region).Filter(filter).Pages(context.Background(), func(res *computeBeta.AddressList) error {
+		for _, betaAddress := range res.Items {
+			address := &compute.Address{}
+			if err := Convert(betaAddress, address); err != nil {
+				return err
+			}
+			address.SelfLink = ConvertSelfLinkToV1(address.SelfLink)
+
+			item, err := datasourceListItem(itemSchema, func(id *schema.ResourceData) error {
+				setComputeAddressDataSourceFields(id, project, region, address)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("Error flattening address %q: %s", address.Name, err)
+			}
+			addresses = append(addresses, item)
+			selfLinks = append(selfLinks, address.SelfLink)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing addresses in %s/%s: %s", project, region, err)
+	}
+
+	if err := d.Set("addresses", addresses); err != nil {
+		return fmt.Errorf("Error setting addresses: %s", err)
+	}
+	d.Set("self_links", selfLinks)
+	d.Set("project", project)
+	d.Set("region", region)
+	d.SetId(time.Now().UTC().String())
+
+	return nil
+}
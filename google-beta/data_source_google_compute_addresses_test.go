@@ -0,0 +1,43 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceComputeAddresses(t *testing.T) {
+	t.Parallel()
+
+	addressName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceComputeAddressesConfig(addressName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_addresses.my_addresses", "addresses.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_compute_addresses.my_addresses", "addresses.0.address", "google_compute_address.foobar", "address"),
+					resource.TestCheckResourceAttrPair("data.google_compute_addresses.my_addresses", "addresses.0.self_link", "google_compute_address.foobar", "self_link"),
+					resource.TestCheckResourceAttr("data.google_compute_addresses.my_addresses", "addresses.0.status", "RESERVED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeAddressesConfig(name string) string {
+	return fmt.Sprintf(`
+resource "google_compute_address" "foobar" {
+	name   = "%s"
+	region = "us-central1"
+}
+
+data "google_compute_addresses" "my_addresses" {
+	region = "us-central1"
+	filter = "name = \"${google_compute_address.foobar.name}\""
+}`, name)
+}
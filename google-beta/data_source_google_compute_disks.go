@@ -0,0 +1,129 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleComputeDisks() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceGoogleComputeDisksRead,
+		Schema: computeListDataSourceSchema("disks", "zone", true, computeDisksItemSchema()),
+	}
+}
+
+// The attributes of each disk are those of the google_compute_disk resource.
+func computeDisksItemSchema() map[string]*schema.Schema {
+	return datasourceSchemaFromResourceSchema(resourceComputeDisk().Schema)
+}
+
+func dataSourceGoogleComputeDisksRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	itemSchema := computeDisksItemSchema()
+	disks := make([]map[string]interface{}, 0)
+	selfLinks := make([]string, 0)
+
+	filter := computeListFilter(d)
+	log.Printf("[DEBUG] Listing disks in %s/%s with filter %q", project, zone, filter)
+
+	// Disks are listed with the same API version as the resource reads them,
+	// so the resource's flattening code can be used as-is.
+	params := map[string]string{}
+	if filter != "" {
+		params["filter"] = filter
+	}
+	for {
+		url, err := addQueryParams(fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/zones/%s/disks", project, zone), params)
+		if err != nil {
+			return err
+		}
+
+		res, err := sendRequest(config, "GET", url, nil)
+		if err != nil {
+			return fmt.Errorf("Error listing disks in %s/%s: %s", project, zone, err)
+		}
+
+		items, _ := res["items"].([]interface{})
+		for _, v := range items {
+			disk := v.(map[string]interface{})
+			item, err := datasourceListItem(itemSchema, func(id *schema.ResourceData) error {
+				return setComputeDiskDataSourceFields(id, meta, project, disk)
+			})
+			if err != nil {
+				return fmt.Errorf("Error flattening disk %q: %s", disk["name"], err)
+			}
+			disks = append(disks, item)
+			selfLinks = append(selfLinks, item["self_link"].(string))
+		}
+
+		pageToken, _ := res["nextPageToken"].(string)
+		if pageToken == "" {
+			break
+		}
+		params["pageToken"] = pageToken
+	}
+
+	if err := d.Set("disks", disks); err != nil {
+		return fmt.Errorf("Error setting disks: %s", err)
+	}
+	d.Set("self_links", selfLinks)
+	d.Set("project", project)
+	d.Set("zone", zone)
+	d.SetId(time.Now().UTC().String())
+
+	return nil
+}
+
+// setComputeDiskDataSourceFields sets the attributes of a disk from a list
+// response, the same way resourceComputeDiskRead sets them.
+func setComputeDiskDataSourceFields(d *schema.ResourceData, meta interface{}, project string, res map[string]interface{}) error {
+	res, err := resourceComputeDiskDecoder(d, meta, res)
+	if err != nil {
+		return err
+	}
+
+	d.Set("project", project)
+	d.Set("label_fingerprint", flattenComputeDiskLabelFingerprint(res["labelFingerprint"], d))
+	d.Set("creation_timestamp", flattenComputeDiskCreationTimestamp(res["creationTimestamp"], d))
+	d.Set("description", flattenComputeDiskDescription(res["description"], d))
+	d.Set("last_attach_timestamp", flattenComputeDiskLastAttachTimestamp(res["lastAttachTimestamp"], d))
+	d.Set("last_detach_timestamp", flattenComputeDiskLastDetachTimestamp(res["lastDetachTimestamp"], d))
+	d.Set("labels", flattenComputeDiskLabels(res["labels"], d))
+	d.Set("name", flattenComputeDiskName(res["name"], d))
+	d.Set("size", flattenComputeDiskSize(res["sizeGb"], d))
+	d.Set("users", flattenComputeDiskUsers(res["users"], d))
+	d.Set("type", flattenComputeDiskType(res["type"], d))
+	d.Set("image", flattenComputeDiskImage(res["sourceImage"], d))
+	d.Set("zone", flattenComputeDiskZone(res["zone"], d))
+	d.Set("source_image_id", flattenComputeDiskSourceImageId(res["sourceImageId"], d))
+	d.Set("snapshot", flattenComputeDiskSnapshot(res["sourceSnapshot"], d))
+	d.Set("source_snapshot_id", flattenComputeDiskSourceSnapshotId(res["sourceSnapshotId"], d))
+
+	if err := d.Set("source_image_encryption_key", flattenComputeDiskSourceImageEncryptionKey(res["sourceImageEncryptionKey"], d)); err != nil {
+		return err
+	}
+	if err := d.Set("disk_encryption_key", flattenComputeDiskDiskEncryptionKey(res["diskEncryptionKey"], d)); err != nil {
+		return err
+	}
+	if err := d.Set("source_snapshot_encryption_key", flattenComputeDiskSourceSnapshotEncryptionKey(res["sourceSnapshotEncryptionKey"], d)); err != nil {
+		return err
+	}
+
+	selfLink := ConvertSelfLinkToV1(res["selfLink"].(string))
+	d.Set("self_link", selfLink)
+	d.SetId(selfLink)
+	return nil
+}
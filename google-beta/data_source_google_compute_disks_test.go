@@ -0,0 +1,67 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceComputeDisks_basic(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeDiskDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceComputeDisksConfig(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_disks.foo", "disks.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_compute_disks.foo", "disks.0.self_link", "google_compute_disk.foo", "self_link"),
+					resource.TestCheckResourceAttr("data.google_compute_disks.foo", "disks.0.size", "20"),
+					resource.TestCheckResourceAttr("data.google_compute_disks.foo", "disks.0.labels.env", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeDisksConfig(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_disk" "foo" {
+	name = "tf-test-disks-%s"
+	zone = "us-central1-a"
+	size = 20
+
+	labels {
+		env = "test"
+	}
+}
+
+resource "google_compute_disk" "bar" {
+	name = "tf-test-disks-%s-other"
+	zone = "us-central1-a"
+	size = 10
+
+	labels {
+		env = "other"
+	}
+}
+
+data "google_compute_disks" "foo" {
+	zone   = "us-central1-a"
+	filter = "name = \"tf-test-disks-%s*\""
+
+	labels {
+		env = "test"
+	}
+
+	depends_on = ["google_compute_disk.foo", "google_compute_disk.bar"]
+}
+`, suffix, suffix, suffix)
+}
@@ -2,7 +2,9 @@ package google
 
 import (
 	"fmt"
+
 	"github.com/hashicorp/terraform/helper/schema"
+	computeBeta "google.golang.org/api/compute/v0.beta"
 )
 
 func dataSourceGoogleComputeInstance() *schema.Resource {
@@ -34,8 +36,12 @@ func dataSourceGoogleComputeInstanceRead(d *schema.ResourceData, meta interface{
 		return handleNotFoundError(err, d, fmt.Sprintf("Instance %s", name))
 	}
 
+	return setComputeInstanceDataSourceFields(d, config, project, instance)
+}
+
+func setComputeInstanceDataSourceFields(d *schema.ResourceData, config *Config, project string, instance *computeBeta.Instance) error {
 	md := flattenMetadataBeta(instance.Metadata)
-	if err = d.Set("metadata", md); err != nil {
+	if err := d.Set("metadata", md); err != nil {
 		return fmt.Errorf("error setting metadata: %s", err)
 	}
 
@@ -0,0 +1,70 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	computeBeta "google.golang.org/api/compute/v0.beta"
+)
+
+func dataSourceGoogleComputeInstances() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceGoogleComputeInstancesRead,
+		Schema: computeListDataSourceSchema("instances", "zone", true, computeInstancesItemSchema()),
+	}
+}
+
+// The attributes of each instance are those of the google_compute_instance
+// data source.
+func computeInstancesItemSchema() map[string]*schema.Schema {
+	return datasourceSchemaFromResourceSchema(dataSourceGoogleComputeInstance().Schema)
+}
+
+func dataSourceGoogleComputeInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	itemSchema := computeInstancesItemSchema()
+	instances := make([]map[string]interface{}, 0)
+	selfLinks := make([]string, 0)
+
+	filter := computeListFilter(d)
+	log.Printf("[DEBUG] Listing instances in %s/%s with filter %q", project, zone, filter)
+	err = config.clientComputeBeta.Instances.List(project, zone).Filter(filter).Pages(context.Background(), func(res *computeBeta.InstanceList) error {
+		for _, instance := range res.Items {
+			item, err := datasourceListItem(itemSchema, func(id *schema.ResourceData) error {
+				return setComputeInstanceDataSourceFields(id, config, project, instance)
+			})
+			if err != nil {
+				return fmt.Errorf("Error flattening instance %q: %s", instance.Name, err)
+			}
+			instances = append(instances, item)
+			selfLinks = append(selfLinks, ConvertSelfLinkToV1(instance.SelfLink))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing instances in %s/%s: %s", project, zone, err)
+	}
+
+	if err := d.Set("instances", instances); err != nil {
+		return fmt.Errorf("Error setting instances: %s", err)
+	}
+	d.Set("self_links", selfLinks)
+	d.Set("project", project)
+	d.Set("zone", zone)
+	d.SetId(time.Now().UTC().String())
+
+	return nil
+}
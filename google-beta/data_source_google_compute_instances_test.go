@@ -0,0 +1,91 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceComputeInstances_basic(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceComputeInstancesConfig(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_instances.by_label", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_compute_instances.by_label", "instances.0.self_link", "google_compute_instance.labeled", "self_link"),
+					resource.TestCheckResourceAttr("data.google_compute_instances.by_label", "instances.0.machine_type", "n1-standard-1"),
+					resource.TestCheckResourceAttr("data.google_compute_instances.by_label", "instances.0.labels.test_id", suffix),
+					resource.TestCheckResourceAttr("data.google_compute_instances.by_filter", "instances.#", "2"),
+					resource.TestCheckResourceAttr("data.google_compute_instances.by_filter", "self_links.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeInstancesConfig(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance" "labeled" {
+	name         = "tf-test-instances-%s-1"
+	machine_type = "n1-standard-1"
+	zone         = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image = "debian-cloud/debian-9"
+		}
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	labels {
+		test_id = "%s"
+	}
+}
+
+resource "google_compute_instance" "unlabeled" {
+	name         = "tf-test-instances-%s-2"
+	machine_type = "n1-standard-1"
+	zone         = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image = "debian-cloud/debian-9"
+		}
+	}
+
+	network_interface {
+		network = "default"
+	}
+}
+
+data "google_compute_instances" "by_label" {
+	zone = "us-central1-a"
+
+	labels {
+		test_id = "%s"
+	}
+
+	depends_on = ["google_compute_instance.labeled", "google_compute_instance.unlabeled"]
+}
+
+data "google_compute_instances" "by_filter" {
+	zone   = "us-central1-a"
+	filter = "name = \"tf-test-instances-%s-*\""
+
+	depends_on = ["google_compute_instance.labeled", "google_compute_instance.unlabeled"]
+}
+`, suffix, suffix, suffix, suffix, suffix)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
 )
 
 func dataSourceGoogleComputeNetwork() *schema.Resource {
@@ -57,10 +58,15 @@ func dataSourceGoogleComputeNetworkRead(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Network Not Found : %s", name))
 	}
+	setComputeNetworkDataSourceFields(d, network)
+	return nil
+}
+
+func setComputeNetworkDataSourceFields(d *schema.ResourceData, network *compute.Network) {
+	d.Set("name", network.Name)
 	d.Set("gateway_ipv4", network.GatewayIPv4)
 	d.Set("self_link", network.SelfLink)
 	d.Set("description", network.Description)
 	d.Set("subnetworks_self_links", network.Subnetworks)
 	d.SetId(network.Name)
-	return nil
 }
@@ -0,0 +1,67 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+func dataSourceGoogleComputeNetworks() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceGoogleComputeNetworksRead,
+		Schema: computeListDataSourceSchema("networks", "", false, computeNetworksItemSchema()),
+	}
+}
+
+// The attributes of each network are those of the google_compute_network data
+// source.
+func computeNetworksItemSchema() map[string]*schema.Schema {
+	return datasourceSchemaFromResourceSchema(dataSourceGoogleComputeNetwork().Schema)
+}
+
+func dataSourceGoogleComputeNetworksRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	itemSchema := computeNetworksItemSchema()
+	networks := make([]map[string]interface{}, 0)
+	selfLinks := make([]string, 0)
+
+	filter := computeListFilter(d)
+	log.Printf("[DEBUG] Listing networks in %s with filter %q", project, filter)
+	err = config.clientCompute.Networks.List(project).Filter(filter).Pages(context.Background(), func(res *compute.NetworkList) error {
+		for _, network := range res.Items {
+			item, err := datasourceListItem(itemSchema, func(id *schema.ResourceData) error {
+				id.Set("project", project)
+				setComputeNetworkDataSourceFields(id, network)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("Error flattening network %q: %s", network.Name, err)
+			}
+			networks = append(networks, item)
+			selfLinks = append(selfLinks, network.SelfLink)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing networks in %s: %s", project, err)
+	}
+
+	if err := d.Set("networks", networks); err != nil {
+		return fmt.Errorf("Error setting networks: %s", err)
+	}
+	d.Set("self_links", selfLinks)
+	d.Set("project", project)
+	d.SetId(time.Now().UTC().String())
+
+	return nil
+}
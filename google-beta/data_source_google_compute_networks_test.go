@@ -0,0 +1,42 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleNetworks(t *testing.T) {
+	t.Parallel()
+
+	networkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceGoogleNetworksConfig(networkName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_networks.my_networks", "networks.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_compute_networks.my_networks", "networks.0.self_link", "google_compute_network.foobar", "self_link"),
+					resource.TestCheckResourceAttrPair("data.google_compute_networks.my_networks", "networks.0.name", "google_compute_network.foobar", "name"),
+					resource.TestCheckResourceAttr("data.google_compute_networks.my_networks", "networks.0.description", "my-description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleNetworksConfig(name string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "foobar" {
+	name = "%s"
+	description = "my-description"
+}
+
+data "google_compute_networks" "my_networks" {
+	filter = "name = \"${google_compute_network.foobar.name}\""
+}`, name)
+}
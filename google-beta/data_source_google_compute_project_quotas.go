@@ -0,0 +1,85 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+func dataSourceGoogleComputeProjectQuotas() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeProjectQuotasRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"quotas": computeQuotasSchema(),
+		},
+	}
+}
+
+func dataSourceGoogleComputeProjectQuotasRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	p, err := config.clientCompute.Projects.Get(project).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading quotas for project %q: %s", project, err)
+	}
+
+	if err := d.Set("quotas", flattenComputeQuotas(p.Quotas)); err != nil {
+		return fmt.Errorf("Error setting quotas: %s", err)
+	}
+	d.Set("project", project)
+	d.SetId(project)
+
+	return nil
+}
+
+func computeQuotasSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"metric": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"limit": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"usage": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// flattenComputeQuotas sorts quotas by metric, so they can be looked up by
+// index in configuration.
+func flattenComputeQuotas(quotas []*compute.Quota) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(quotas))
+	for _, q := range quotas {
+		result = append(result, map[string]interface{}{
+			"metric": q.Metric,
+			"limit":  q.Limit,
+			"usage":  q.Usage,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i]["metric"].(string) < result[j]["metric"].(string)
+	})
+	return result
+}
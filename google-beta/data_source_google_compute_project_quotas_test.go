@@ -0,0 +1,31 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleComputeProjectQuotas(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeProjectQuotasConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.google_compute_project_quotas.quotas", "project"),
+					resource.TestCheckResourceAttrSet("data.google_compute_project_quotas.quotas", "quotas.0.metric"),
+					resource.TestCheckResourceAttrSet("data.google_compute_project_quotas.quotas", "quotas.0.limit"),
+					resource.TestCheckResourceAttrSet("data.google_compute_project_quotas.quotas", "quotas.0.usage"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceGoogleComputeProjectQuotasConfig = `
+data "google_compute_project_quotas" "quotas" {}
+`
@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleComputeRegionQuotas() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeRegionQuotasRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"quotas": computeQuotasSchema(),
+		},
+	}
+}
+
+func dataSourceGoogleComputeRegionQuotasRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	r, err := config.clientCompute.Regions.Get(project, region).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading quotas for region %q: %s", region, err)
+	}
+
+	if err := d.Set("quotas", flattenComputeQuotas(r.Quotas)); err != nil {
+		return fmt.Errorf("Error setting quotas: %s", err)
+	}
+	d.Set("project", project)
+	d.Set("region", region)
+	d.SetId(fmt.Sprintf("%s/%s", project, region))
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleComputeRegionQuotas(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeRegionQuotasConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_region_quotas.quotas", "region", "us-central1"),
+					resource.TestCheckResourceAttrSet("data.google_compute_region_quotas.quotas", "quotas.0.metric"),
+					resource.TestCheckResourceAttrSet("data.google_compute_region_quotas.quotas", "quotas.0.limit"),
+					resource.TestCheckResourceAttrSet("data.google_compute_region_quotas.quotas", "quotas.0.usage"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceGoogleComputeRegionQuotasConfig = `
+data "google_compute_region_quotas" "quotas" {
+  region = "us-central1"
+}
+`
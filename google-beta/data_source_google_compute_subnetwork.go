@@ -91,6 +91,12 @@ func dataSourceGoogleComputeSubnetworkRead(d *schema.ResourceData, meta interfac
 		return handleNotFoundError(err, d, fmt.Sprintf("Subnetwork Not Found : %s", name))
 	}
 
+	setComputeSubnetworkDataSourceFields(d, project, region, subnetwork)
+	return nil
+}
+
+func setComputeSubnetworkDataSourceFields(d *schema.ResourceData, project, region string, subnetwork *compute.Subnetwork) {
+	d.Set("name", subnetwork.Name)
 	d.Set("ip_cidr_range", subnetwork.IpCidrRange)
 	d.Set("private_ip_google_access", subnetwork.PrivateIpGoogleAccess)
 	d.Set("self_link", subnetwork.SelfLink)
@@ -105,7 +111,6 @@ func dataSourceGoogleComputeSubnetworkRead(d *schema.ResourceData, meta interfac
 	//Subnet id creation is defined in resource_compute_subnetwork.go
 	subnetwork.Region = region
 	d.SetId(createSubnetID(subnetwork))
-	return nil
 }
 
 func flattenSecondaryRanges(secondaryRanges []*compute.SubnetworkSecondaryRange) []map[string]interface{} {
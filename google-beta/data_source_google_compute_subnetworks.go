@@ -0,0 +1,71 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+func dataSourceGoogleComputeSubnetworks() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceGoogleComputeSubnetworksRead,
+		Schema: computeListDataSourceSchema("subnetworks", "region", false, computeSubnetworksItemSchema()),
+	}
+}
+
+// The attributes of each subnetwork are those of the google_compute_subnetwork
+// data source.
+func computeSubnetworksItemSchema() map[string]*schema.Schema {
+	return datasourceSchemaFromResourceSchema(dataSourceGoogleComputeSubnetwork().Schema)
+}
+
+func dataSourceGoogleComputeSubnetworksRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	itemSchema := computeSubnetworksItemSchema()
+	subnetworks := make([]map[string]interface{}, 0)
+	selfLinks := make([]string, 0)
+
+	filter := computeListFilter(d)
+	log.Printf("[DEBUG] Listing subnetworks in %s/%s with filter %q", project, region, filter)
+	err = config.clientCompute.Subnetworks.List(project, region).Filter(filter).Pages(context.Background(), func(res *compute.SubnetworkList) error {
+		for _, subnetwork := range res.Items {
+			item, err := datasourceListItem(itemSchema, func(id *schema.ResourceData) error {
+				setComputeSubnetworkDataSourceFields(id, project, region, subnetwork)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("Error flattening subnetwork %q: %s", subnetwork.Name, err)
+			}
+			subnetworks = append(subnetworks, item)
+			selfLinks = append(selfLinks, subnetwork.SelfLink)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing subnetworks in %s/%s: %s", project, region, err)
+	}
+
+	if err := d.Set("subnetworks", subnetworks); err != nil {
+		return fmt.Errorf("Error setting subnetworks: %s", err)
+	}
+	d.Set("self_links", selfLinks)
+	d.Set("project", project)
+	d.Set("region", region)
+	d.SetId(time.Now().UTC().String())
+
+	return nil
+}
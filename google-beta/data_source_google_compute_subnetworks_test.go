@@ -0,0 +1,58 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleSubnetworks(t *testing.T) {
+	t.Parallel()
+
+	networkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceGoogleSubnetworksConfig(networkName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_subnetworks.my_subnetworks", "subnetworks.#", "2"),
+					resource.TestCheckResourceAttr("data.google_compute_subnetworks.my_subnetworks", "self_links.#", "2"),
+					resource.TestCheckResourceAttrPair("data.google_compute_subnetworks.my_subnetworks", "subnetworks.0.network", "google_compute_network.foobar", "self_link"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleSubnetworksConfig(name string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "foobar" {
+	name = "%s"
+	auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "foo" {
+	name          = "%s-1"
+	ip_cidr_range = "10.0.0.0/24"
+	region        = "us-central1"
+	network       = "${google_compute_network.foobar.self_link}"
+}
+
+resource "google_compute_subnetwork" "bar" {
+	name          = "%s-2"
+	ip_cidr_range = "10.0.1.0/24"
+	region        = "us-central1"
+	network       = "${google_compute_network.foobar.self_link}"
+}
+
+data "google_compute_subnetworks" "my_subnetworks" {
+	region = "us-central1"
+	filter = "network = \"${google_compute_network.foobar.self_link}\""
+
+	depends_on = ["google_compute_subnetwork.foo", "google_compute_subnetwork.bar"]
+}`, name, name, name)
+}
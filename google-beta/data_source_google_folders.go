@@ -0,0 +1,123 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	resourceManagerV2Beta1 "google.golang.org/api/cloudresourcemanager/v2beta1"
+)
+
+func dataSourceGoogleFolders() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleFoldersRead,
+		Schema: map[string]*schema.Schema{
+			"parent": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFoldersParent,
+			},
+			"recursive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"folders": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lifecycle_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleFoldersRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	parent := d.Get("parent").(string)
+	folders, err := listFolders(config, parent, d.Get("recursive").(bool))
+	if err != nil {
+		return err
+	}
+
+	flattened := make([]map[string]interface{}, 0, len(folders))
+	for _, folder := range folders {
+		flattened = append(flattened, map[string]interface{}{
+			"name":            folder.Name,
+			"parent":          folder.Parent,
+			"display_name":    folder.DisplayName,
+			"lifecycle_state": folder.LifecycleState,
+			"create_time":     folder.CreateTime,
+		})
+	}
+
+	if err := d.Set("folders", flattened); err != nil {
+		return fmt.Errorf("Error setting folders: %s", err)
+	}
+	d.SetId(parent)
+
+	return nil
+}
+
+// listFolders lists the folders directly under parent or, if recursive is set,
+// all of its descendants. Each folder is listed before its children.
+func listFolders(config *Config, parent string, recursive bool) ([]*resourceManagerV2Beta1.Folder, error) {
+	log.Printf("[DEBUG] Listing folders under %s", parent)
+
+	folders := make([]*resourceManagerV2Beta1.Folder, 0)
+	err := config.clientResourceManagerV2Beta1.Folders.List().Parent(parent).Pages(context.Background(), func(res *resourceManagerV2Beta1.ListFoldersResponse) error {
+		folders = append(folders, res.Folders...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error listing folders under %s: %s", parent, err)
+	}
+
+	if !recursive {
+		return folders, nil
+	}
+
+	result := make([]*resourceManagerV2Beta1.Folder, 0, len(folders))
+	for _, folder := range folders {
+		children, err := listFolders(config, folder.Name, true)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, folder)
+		result = append(result, children...)
+	}
+	return result, nil
+}
+
+func validateFoldersParent(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !strings.HasPrefix(value, "organizations/") && !strings.HasPrefix(value, "folders/") {
+		errors = append(errors, fmt.Errorf("%q must be in the form organizations/{organization_id} or folders/{folder_id}, got %q", k, value))
+	}
+	return
+}
@@ -0,0 +1,66 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleFolders_recursive(t *testing.T) {
+	org := getTestOrgFromEnv(t)
+
+	parent := fmt.Sprintf("organizations/%s", org)
+	displayName := "terraform-test-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGoogleFoldersConfig(parent, displayName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_folders.recursive", "folders.#", "2"),
+					resource.TestCheckResourceAttrPair("data.google_folders.recursive", "folders.0.name", "google_folder.child", "name"),
+					resource.TestCheckResourceAttrPair("data.google_folders.recursive", "folders.1.name", "google_folder.grandchild", "name"),
+					resource.TestCheckResourceAttrPair("data.google_folders.recursive", "folders.1.parent", "google_folder.child", "name"),
+					resource.TestCheckResourceAttr("data.google_folders.direct", "folders.#", "1"),
+					resource.TestCheckResourceAttr("data.google_folders.direct", "folders.0.display_name", displayName+"-child"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGoogleFoldersConfig(parent, displayName string) string {
+	return fmt.Sprintf(`
+resource "google_folder" "root" {
+  parent       = "%s"
+  display_name = "%s"
+}
+
+resource "google_folder" "child" {
+  parent       = "${google_folder.root.name}"
+  display_name = "%s-child"
+}
+
+resource "google_folder" "grandchild" {
+  parent       = "${google_folder.child.name}"
+  display_name = "%s-grandchild"
+}
+
+data "google_folders" "recursive" {
+  parent = "${google_folder.root.name}"
+
+  depends_on = ["google_folder.grandchild"]
+}
+
+data "google_folders" "direct" {
+  parent    = "${google_folder.root.name}"
+  recursive = false
+
+  depends_on = ["google_folder.grandchild"]
+}
+`, parent, displayName, displayName, displayName)
+}
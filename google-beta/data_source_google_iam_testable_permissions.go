@@ -0,0 +1,137 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/iam/v1"
+)
+
+func dataSourceGoogleIamTestablePermissions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleIamTestablePermissionsRead,
+		Schema: map[string]*schema.Schema{
+			"full_resource_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stages": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"ALPHA", "BETA", "GA", "DEPRECATED"}, false),
+				},
+			},
+			"custom_support_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "SUPPORTED",
+				ValidateFunc: validation.StringInSlice([]string{"NOT_SUPPORTED", "SUPPORTED", "TESTING"}, false),
+			},
+			"permissions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"custom_support_level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stage": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"api_disabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleIamTestablePermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	fullResourceName := d.Get("full_resource_name").(string)
+
+	permissions, err := queryTestablePermissions(config, fullResourceName)
+	if err != nil {
+		return err
+	}
+
+	stages := make(map[string]bool)
+	for _, s := range d.Get("stages").([]interface{}) {
+		stages[s.(string)] = true
+	}
+	supportLevel := d.Get("custom_support_level").(string)
+
+	result := make([]map[string]interface{}, 0)
+	for _, p := range permissions {
+		if customRolesSupportLevel(p) != supportLevel {
+			continue
+		}
+		if len(stages) > 0 && !stages[p.Stage] {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":                 p.Name,
+			"title":                p.Title,
+			"custom_support_level": customRolesSupportLevel(p),
+			"stage":                p.Stage,
+			"api_disabled":         p.ApiDisabled,
+		})
+	}
+
+	d.SetId(fullResourceName)
+	if err := d.Set("permissions", result); err != nil {
+		return fmt.Errorf("Error setting permissions: %s", err)
+	}
+
+	return nil
+}
+
+// Lists every permission that can be tested on the resource, following pagination.
+func queryTestablePermissions(config *Config, fullResourceName string) ([]*iam.Permission, error) {
+	permissions := make([]*iam.Permission, 0)
+	req := &iam.QueryTestablePermissionsRequest{
+		FullResourceName: fullResourceName,
+		PageSize:         1000,
+	}
+
+	for {
+		resp, err := config.clientIAM.Permissions.QueryTestablePermissions(req).Do()
+		if err != nil {
+			return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving testable permissions for %q: {{err}}", fullResourceName), err)
+		}
+
+		permissions = append(permissions, resp.Permissions...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+
+	return permissions, nil
+}
+
+// The API omits customRolesSupportLevel when it's the default.
+func customRolesSupportLevel(p *iam.Permission) string {
+	if p.CustomRolesSupportLevel == "" {
+		return "SUPPORTED"
+	}
+	return p.CustomRolesSupportLevel
+}
@@ -0,0 +1,65 @@
+package google
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceGoogleIamTestablePermissions_basic(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "google_iam_testable_permissions" "perms" {
+  full_resource_name = "//cloudresourcemanager.googleapis.com/projects/%s"
+}
+`, project),
+				Check: testAccCheckGoogleIamTestablePermissionsMeta("data.google_iam_testable_permissions.perms", "SUPPORTED"),
+			},
+			{
+				Config: fmt.Sprintf(`
+data "google_iam_testable_permissions" "perms" {
+  full_resource_name   = "//cloudresourcemanager.googleapis.com/projects/%s"
+  stages               = ["GA"]
+  custom_support_level = "NOT_SUPPORTED"
+}
+`, project),
+				Check: testAccCheckGoogleIamTestablePermissionsMeta("data.google_iam_testable_permissions.perms", "NOT_SUPPORTED"),
+			},
+		},
+	})
+}
+
+func testAccCheckGoogleIamTestablePermissionsMeta(n, supportLevel string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find testable permissions data source: %s", n)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["permissions.#"])
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return fmt.Errorf("Expected at least one %s permission", supportLevel)
+		}
+
+		for i := 0; i < count; i++ {
+			if got := rs.Primary.Attributes[fmt.Sprintf("permissions.%d.custom_support_level", i)]; got != supportLevel {
+				return fmt.Errorf("Expected permission %d to have support level %s, got %s", i, supportLevel, got)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,102 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func dataSourceGoogleProjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleProjectsRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"projects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"lifecycle_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleProjectsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	filter := d.Get("filter").(string)
+	projects := make([]map[string]interface{}, 0)
+
+	log.Printf("[DEBUG] Listing projects with filter %q", filter)
+	err := config.clientResourceManager.Projects.List().Filter(filter).Pages(context.Background(), func(res *cloudresourcemanager.ListProjectsResponse) error {
+		for _, project := range res.Projects {
+			projects = append(projects, flattenListedProject(project))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing projects with filter %q: %s", filter, err)
+	}
+
+	if err := d.Set("projects", projects); err != nil {
+		return fmt.Errorf("Error setting projects: %s", err)
+	}
+	d.SetId(filter)
+
+	return nil
+}
+
+func flattenListedProject(project *cloudresourcemanager.Project) map[string]interface{} {
+	m := map[string]interface{}{
+		"project_id":      project.ProjectId,
+		"number":          fmt.Sprintf("%d", project.ProjectNumber),
+		"name":            project.Name,
+		"labels":          project.Labels,
+		"lifecycle_state": project.LifecycleState,
+		"create_time":     project.CreateTime,
+	}
+
+	// Parents are in the same form as folders' parents, e.g. "folders/123".
+	if project.Parent != nil {
+		m["parent"] = fmt.Sprintf("%ss/%s", project.Parent.Type, project.Parent.Id)
+	}
+
+	return m
+}
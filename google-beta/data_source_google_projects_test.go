@@ -0,0 +1,42 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleProjects_basic(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGoogleProjectsConfig(project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_projects.my-project", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.google_projects.my-project", "projects.0.project_id", project),
+					resource.TestCheckResourceAttr("data.google_projects.my-project", "projects.0.lifecycle_state", "ACTIVE"),
+					resource.TestCheckResourceAttrPair("data.google_projects.my-project", "projects.0.number", "data.google_project.my-project", "number"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGoogleProjectsConfig(project string) string {
+	return fmt.Sprintf(`
+data "google_project" "my-project" {
+  project_id = "%s"
+}
+
+data "google_projects" "my-project" {
+  filter = "id:%s"
+}
+`, project, project)
+}
@@ -0,0 +1,90 @@
+package google
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+var IamPolicyDataSourceBaseSchema = map[string]*schema.Schema{
+	"binding": {
+		Type:     schema.TypeSet,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"members": {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
+				},
+			},
+		},
+	},
+	"policy_data": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"etag": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+// DataSourceIamPolicy returns a read-only view of the IAM policy currently attached to
+// a resource, using the same parent schema and updater as the resource's IAM resources.
+func DataSourceIamPolicy(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc) *schema.Resource {
+	return &schema.Resource{
+		Read: DataSourceIamPolicyRead(newUpdaterFunc),
+
+		Schema: mergeSchemas(IamPolicyDataSourceBaseSchema, iamDataSourceParentSchema(parentSpecificSchema)),
+	}
+}
+
+func DataSourceIamPolicyRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		policy, err := updater.GetResourceIamPolicy()
+		if err != nil {
+			return err
+		}
+
+		d.SetId(updater.GetResourceId())
+		d.Set("etag", policy.Etag)
+		d.Set("policy_data", marshalIamPolicy(policy))
+		d.Set("binding", flattenIamPolicyBindings(policy.Bindings))
+
+		return nil
+	}
+}
+
+// The parent schemas are shared with the IAM resources, where identifying fields
+// are ForceNew. That has no meaning for a data source, so copy them without it.
+func iamDataSourceParentSchema(parentSpecificSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(parentSpecificSchema))
+	for k, v := range parentSpecificSchema {
+		dv := *v
+		dv.ForceNew = false
+		ds[k] = &dv
+	}
+	return ds
+}
+
+func flattenIamPolicyBindings(bindings []*cloudresourcemanager.Binding) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(bindings))
+	for _, b := range mergeBindings(bindings) {
+		result = append(result, map[string]interface{}{
+			"role":    b.Role,
+			"members": schema.NewSet(schema.HashString, convertStringArrToInterface(b.Members)),
+		})
+	}
+	return result
+}
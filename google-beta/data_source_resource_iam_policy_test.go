@@ -0,0 +1,98 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceProjectIamPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceProjectIamPolicy_basic(getTestProjectFromEnv()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.google_project_iam_policy.policy", "etag"),
+					resource.TestCheckResourceAttrSet("data.google_project_iam_policy.policy", "policy_data"),
+					testAccCheckDataSourceIamPolicyHasBindings("data.google_project_iam_policy.policy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourcePubsubTopicIamPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	topic := "tf-test-topic-iam-" + acctest.RandString(10)
+	account := "tf-test-topic-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePubsubTopicIamPolicy_basic(topic, account),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_pubsub_topic_iam_policy.policy", "binding.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.google_pubsub_topic_iam_policy.policy", "etag",
+						"google_pubsub_topic_iam_binding.foo", "etag"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceIamPolicyHasBindings(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find IAM policy data source: %s", n)
+		}
+
+		if ds.Primary.Attributes["binding.#"] == "" || ds.Primary.Attributes["binding.#"] == "0" {
+			return fmt.Errorf("Expected %s to have at least one binding", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccDataSourceProjectIamPolicy_basic(project string) string {
+	return fmt.Sprintf(`
+data "google_project_iam_policy" "policy" {
+  project = "%s"
+}
+`, project)
+}
+
+func testAccDataSourcePubsubTopicIamPolicy_basic(topic, account string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_topic" "topic" {
+  name = "%s"
+}
+
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Pubsub topic IAM data source test account"
+}
+
+resource "google_pubsub_topic_iam_binding" "foo" {
+  topic   = "${google_pubsub_topic.topic.id}"
+  role    = "roles/pubsub.publisher"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+
+data "google_pubsub_topic_iam_policy" "policy" {
+  topic = "${google_pubsub_topic_iam_binding.foo.topic}"
+}
+`, topic, account)
+}
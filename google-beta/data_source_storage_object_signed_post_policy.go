@@ -0,0 +1,267 @@
+package google
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"golang.org/x/oauth2/jwt"
+)
+
+func dataSourceGoogleSignedPostPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleSignedPostPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"key_prefix"},
+			},
+			"key_prefix": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"key"},
+			},
+			"content_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_length_range": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"max": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"credentials": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"duration": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "1h",
+			},
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signature": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fields": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleSignedPostPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	policyData := &PostPolicyData{
+		Bucket:      d.Get("bucket").(string),
+		Key:         d.Get("key").(string),
+		KeyPrefix:   d.Get("key_prefix").(string),
+		ContentType: d.Get("content_type").(string),
+	}
+
+	if policyData.Key == "" && policyData.KeyPrefix == "" {
+		return errors.New("one of key or key_prefix must be set")
+	}
+
+	if v, ok := d.GetOk("content_length_range"); ok {
+		lengthRange := v.([]interface{})[0].(map[string]interface{})
+		policyData.ContentLengthRange = []int{lengthRange["min"].(int), lengthRange["max"].(int)}
+	}
+
+	// convert duration to an expiration datetime
+	duration, err := time.ParseDuration(d.Get("duration").(string))
+	if err != nil {
+		return errwrap.Wrapf("could not parse duration: {{err}}", err)
+	}
+	if duration > gcsV4MaxExpiry {
+		return fmt.Errorf("duration %q is longer than the maximum of %s allowed for v4 signatures", d.Get("duration").(string), gcsV4MaxExpiry)
+	}
+	policyData.Date = time.Now().UTC()
+	policyData.Expires = policyData.Date.Add(duration)
+
+	// Load JWT Config from Google Credentials
+	jwtConfig, err := loadJwtConfig(d, config)
+	if err != nil {
+		return err
+	}
+	policyData.JwtConfig = jwtConfig
+
+	policy, err := policyData.EncodedPolicy()
+	if err != nil {
+		return err
+	}
+
+	signature, err := policyData.EncodedSignature()
+	if err != nil {
+		return err
+	}
+
+	fields, err := policyData.Fields()
+	if err != nil {
+		return err
+	}
+
+	d.Set("url", policyData.Url())
+	d.Set("policy", policy)
+	d.Set("signature", signature)
+	d.Set("fields", fields)
+	d.SetId(signature)
+
+	return nil
+}
+
+// PostPolicyData stores the values required to create a v4 signed POST policy
+// that lets a browser upload an object with an HTML form:
+// see https://cloud.google.com/storage/docs/xml-api/post-object
+type PostPolicyData struct {
+	JwtConfig   *jwt.Config
+	Bucket      string
+	Key         string
+	KeyPrefix   string
+	ContentType string
+	// ContentLengthRange is either empty or holds the minimum and maximum
+	// size of the upload in bytes.
+	ContentLengthRange []int
+	Date               time.Time
+	Expires            time.Time
+}
+
+type postPolicyDocument struct {
+	Conditions []interface{} `json:"conditions"`
+	Expiration string        `json:"expiration"`
+}
+
+// PolicyDocument creates the JSON policy document listing the conditions the
+// upload must meet. Example output:
+// -------------------
+// {"conditions":[{"bucket":"bucket"},{"key":"objectname"},...],"expiration":"2016-08-12T14:03:30Z"}
+// -------------------
+func (p *PostPolicyData) PolicyDocument() ([]byte, error) {
+	conditions := []interface{}{
+		map[string]string{"bucket": p.Bucket},
+	}
+
+	if p.Key != "" {
+		conditions = append(conditions, map[string]string{"key": p.Key})
+	} else {
+		conditions = append(conditions, []interface{}{"starts-with", "$key", p.KeyPrefix})
+	}
+
+	if p.ContentType != "" {
+		conditions = append(conditions, map[string]string{"Content-Type": p.ContentType})
+	}
+
+	if len(p.ContentLengthRange) == 2 {
+		conditions = append(conditions, []interface{}{"content-length-range", p.ContentLengthRange[0], p.ContentLengthRange[1]})
+	}
+
+	conditions = append(conditions,
+		map[string]string{"x-goog-algorithm": gcsV4SigningAlgorithm},
+		map[string]string{"x-goog-credential": p.credential()},
+		map[string]string{"x-goog-date": p.Date.UTC().Format(gcsV4DateTimeFormat)},
+	)
+
+	return json.Marshal(&postPolicyDocument{
+		Conditions: conditions,
+		Expiration: p.Expires.UTC().Format("2006-01-02T15:04:05Z"),
+	})
+}
+
+// EncodedPolicy returns the PolicyDocument() after base64 encoding, which is
+// both the string that is signed and the value of the policy form field
+func (p *PostPolicyData) EncodedPolicy() (string, error) {
+	document, err := p.PolicyDocument()
+	if err != nil {
+		return "", errwrap.Wrapf("could not create policy document: {{err}}", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(document), nil
+}
+
+// EncodedSignature returns the hex encoded signature of the EncodedPolicy()
+func (p *PostPolicyData) EncodedSignature() (string, error) {
+	policy, err := p.EncodedPolicy()
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := SignString([]byte(policy), p.JwtConfig)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(signature), nil
+}
+
+// Fields returns the form fields that must be sent along with the uploaded
+// file. When only a key prefix is set, the form must add its own key field.
+func (p *PostPolicyData) Fields() (map[string]string, error) {
+	policy, err := p.EncodedPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := p.EncodedSignature()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{
+		"policy":            policy,
+		"x-goog-algorithm":  gcsV4SigningAlgorithm,
+		"x-goog-credential": p.credential(),
+		"x-goog-date":       p.Date.UTC().Format(gcsV4DateTimeFormat),
+		"x-goog-signature":  signature,
+	}
+	if p.Key != "" {
+		fields["key"] = p.Key
+	}
+	if p.ContentType != "" {
+		fields["Content-Type"] = p.ContentType
+	}
+
+	return fields, nil
+}
+
+// Url returns the address the form is posted to
+func (p *PostPolicyData) Url() string {
+	return fmt.Sprintf("%s/%s", gcsBaseUrl, p.Bucket)
+}
+
+func (p *PostPolicyData) credential() string {
+	return fmt.Sprintf("%s/%s", p.JwtConfig.Email, gcsV4CredentialScope(p.Date))
+}
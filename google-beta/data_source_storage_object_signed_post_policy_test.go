@@ -0,0 +1,98 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"golang.org/x/oauth2/google"
+)
+
+// The following values were computed independently with `openssl dgst -sha256 -sign`
+// over the base64 encoded policy document, using the fake credentials of the
+// signed url tests.
+const testPostPolicyExpectedPolicy = "eyJjb25kaXRpb25zIjpbeyJidWNrZXQiOiJ0Zi10ZXN0LWJ1Y2tldC02MTU5MjA1Mjk3NzM2ODQ1ODgxIn0sWyJzdGFydHMtd2l0aCIsIiRrZXkiLCJ1cGxvYWRzLyJdLHsiQ29udGVudC1UeXBlIjoiaW1hZ2UvcG5nIn0sWyJjb250ZW50LWxlbmd0aC1yYW5nZSIsMCwxMDQ4NTc2XSx7IngtZ29vZy1hbGdvcml0aG0iOiJHT09HNC1SU0EtU0hBMjU2In0seyJ4LWdvb2ctY3JlZGVudGlhbCI6InVzZXJAZ2NwLXByb2plY3QuaWFtLmdzZXJ2aWNlYWNjb3VudC5jb20vMjAxNjA4MTIvYXV0by9zdG9yYWdlL2dvb2c0X3JlcXVlc3QifSx7IngtZ29vZy1kYXRlIjoiMjAxNjA4MTJUMDEwMzMwWiJ9XSwiZXhwaXJhdGlvbiI6IjIwMTYtMDgtMTJUMDI6MDM6MzBaIn0="
+const testPostPolicyExpectedSignature = "3e1455b6b5e79acd50e18d850e2c1068f7682d5c0ee6677447f1b864c538883b4785d69bc66fa5ee6016a6694aff4c9843ec2f0c63f31cbf260d50a20d4d93ef3a3b288cb364fa7d777c0cbacd174e5ccb90dd0023e47b06ac74a6b4f3e04947ad8ee20f5f4ffae8c563909b51837f85a3ef5fde4ed1d8bc9888cbca3383bc1e41b650312d461e4aa657a59941e5c5a4397ceac66747a714d3348a824f90a6032c03c6f944905c38104fbe22031a8d5d920958fd2bd1acd5a51414d1cceaa1d2c52ea73ecce988a65b91aa5859e06e8aa57437d709659dd5559f628bcbbe65e6d43f12018eb9b9dd639dd73a98ed81d0772891172aba12049188a03312d8da1b"
+
+func TestPostPolicyData_Fields(t *testing.T) {
+	// load fake service account credentials
+	cfg, err := google.JWTConfigFromJSON([]byte(fakeCredentials), "")
+	if err != nil {
+		t.Error(err)
+	}
+
+	policyData := &PostPolicyData{
+		JwtConfig:          cfg,
+		Bucket:             "tf-test-bucket-6159205297736845881",
+		KeyPrefix:          "uploads/",
+		ContentType:        "image/png",
+		ContentLengthRange: []int{0, 1048576},
+		Date:               time.Unix(testUrlV4Date, 0),
+		Expires:            time.Unix(testUrlExpires, 0),
+	}
+
+	expected := map[string]string{
+		"policy":            testPostPolicyExpectedPolicy,
+		"x-goog-algorithm":  "GOOG4-RSA-SHA256",
+		"x-goog-credential": "user@gcp-project.iam.gserviceaccount.com/20160812/auto/storage/goog4_request",
+		"x-goog-date":       "20160812T010330Z",
+		"x-goog-signature":  testPostPolicyExpectedSignature,
+		"Content-Type":      "image/png",
+	}
+
+	result, err := policyData.Fields()
+	if err != nil {
+		t.Errorf("Could not generate post policy fields: %+v", err)
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Fields do not match expected value:\n%#v\n%#v", expected, result)
+	}
+
+	if url := policyData.Url(); url != "https://storage.googleapis.com/tf-test-bucket-6159205297736845881" {
+		t.Errorf("Unexpected url %s", url)
+	}
+}
+
+func TestAccStorageSignedPostPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccStorageSignedPostPolicy_basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_storage_object_signed_post_policy.upload", "url", "https://storage.googleapis.com/"+bucketName),
+					resource.TestCheckResourceAttr("data.google_storage_object_signed_post_policy.upload", "fields.key", "uploads/file.txt"),
+					resource.TestCheckResourceAttrSet("data.google_storage_object_signed_post_policy.upload", "fields.x-goog-signature"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageSignedPostPolicy_basic(bucketName string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name = "%s"
+}
+
+data "google_storage_object_signed_post_policy" "upload" {
+  bucket       = "${google_storage_bucket.bucket.name}"
+  key          = "uploads/file.txt"
+  content_type = "text/plain"
+  duration     = "30m"
+
+  content_length_range {
+    min = 1
+    max = 1024
+  }
+}
+`, bucketName)
+}
@@ -8,6 +8,7 @@ import (
 	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/pem"
 	"errors"
 	"fmt"
@@ -31,6 +32,12 @@ import (
 const gcsBaseUrl = "https://storage.googleapis.com"
 const googleCredentialsEnvVar = "GOOGLE_APPLICATION_CREDENTIALS"
 
+const gcsV4SigningAlgorithm = "GOOG4-RSA-SHA256"
+const gcsV4DateTimeFormat = "20060102T150405Z"
+
+// V4 signatures are only accepted by GCS for up to 7 days after they are made.
+const gcsV4MaxExpiry = 7 * 24 * time.Hour
+
 func dataSourceGoogleSignedUrl() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceGoogleSignedUrlRead,
@@ -75,6 +82,12 @@ func dataSourceGoogleSignedUrl() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"signature_version": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "v2",
+				ValidateFunc: validation.StringInSlice([]string{"v2", "v4"}, false),
+			},
 			"signed_url": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -114,7 +127,13 @@ func dataSourceGoogleSignedUrlRead(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		return errwrap.Wrapf("could not parse duration: {{err}}", err)
 	}
-	expires := time.Now().Unix() + int64(duration.Seconds())
+	urlData.SignatureVersion = d.Get("signature_version").(string)
+	if urlData.SignatureVersion == "v4" && duration > gcsV4MaxExpiry {
+		return fmt.Errorf("duration %q is longer than the maximum of %s allowed for v4 signatures", durationString, gcsV4MaxExpiry)
+	}
+
+	urlData.Date = time.Now().UTC()
+	expires := urlData.Date.Unix() + int64(duration.Seconds())
 	urlData.Expires = int(expires)
 
 	// content_md5 is optional
@@ -240,6 +259,11 @@ type UrlData struct {
 	Expires     int
 	HttpHeaders map[string]string
 	Path        string
+
+	// SignatureVersion is either "v2" (the default when empty) or "v4".
+	SignatureVersion string
+	// Date is the time the url is signed at, only used by v4 signatures.
+	Date time.Time
 }
 
 // SigningString creates a string representation of the UrlData in a form ready for signing:
@@ -300,8 +324,17 @@ func (u *UrlData) Signature() ([]byte, error) {
 	return signature, nil
 }
 
-// EncodedSignature returns the Signature() after base64 encoding and url escaping
+// EncodedSignature returns the Signature() after base64 encoding and url escaping,
+// or after hex encoding for v4 signatures
 func (u *UrlData) EncodedSignature() (string, error) {
+	if u.SignatureVersion == "v4" {
+		signature, err := SignString(u.SigningStringV4(), u.JwtConfig)
+		if err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(signature), nil
+	}
+
 	signature, err := u.Signature()
 	if err != nil {
 		return "", err
@@ -317,6 +350,9 @@ func (u *UrlData) EncodedSignature() (string, error) {
 
 // SignedUrl constructs the final signed URL a client can use to retrieve storage object
 func (u *UrlData) SignedUrl() (string, error) {
+	if u.SignatureVersion == "v4" {
+		return u.SignedUrlV4()
+	}
 
 	encodedSig, err := u.EncodedSignature()
 	if err != nil {
@@ -338,6 +374,169 @@ func (u *UrlData) SignedUrl() (string, error) {
 	return urlBuffer.String(), nil
 }
 
+// CanonicalHeadersV4 returns the lowercased names and the values of the headers
+// included in a v4 signature. The host header is always signed.
+func (u *UrlData) CanonicalHeadersV4() map[string]string {
+	headers := map[string]string{
+		"host": strings.TrimPrefix(gcsBaseUrl, "https://"),
+	}
+	if u.ContentMd5 != "" {
+		headers["content-md5"] = u.ContentMd5
+	}
+	if u.ContentType != "" {
+		headers["content-type"] = u.ContentType
+	}
+	for k, v := range u.HttpHeaders {
+		headers[strings.ToLower(k)] = strings.TrimSpace(v)
+	}
+	return headers
+}
+
+// SignedHeadersV4 returns the sorted, semicolon separated names of the signed headers
+func (u *UrlData) SignedHeadersV4() string {
+	var keys []string
+	for k := range u.CanonicalHeadersV4() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ";")
+}
+
+// CanonicalQueryStringV4 returns the sorted and escaped X-Goog-* query
+// parameters of a v4 signed url, without the signature itself.
+func (u *UrlData) CanonicalQueryStringV4() string {
+	params := map[string]string{
+		"X-Goog-Algorithm":     gcsV4SigningAlgorithm,
+		"X-Goog-Credential":    fmt.Sprintf("%s/%s", u.JwtConfig.Email, gcsV4CredentialScope(u.Date)),
+		"X-Goog-Date":          u.Date.UTC().Format(gcsV4DateTimeFormat),
+		"X-Goog-Expires":       strconv.FormatInt(int64(u.Expires)-u.Date.Unix(), 10),
+		"X-Goog-SignedHeaders": u.SignedHeadersV4(),
+	}
+	var keys []string
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%s", gcsV4Escape(k), gcsV4Escape(params[k])))
+	}
+	return strings.Join(parts, "&")
+}
+
+// CanonicalRequestV4 creates the canonical request of a v4 signature:
+// see https://cloud.google.com/storage/docs/authentication/canonical-requests
+// Example output:
+// -------------------
+// GET
+// /bucket/objectname
+// X-Goog-Algorithm=GOOG4-RSA-SHA256&X-Goog-Credential=...&X-Goog-SignedHeaders=host
+// host:storage.googleapis.com
+//
+// host
+// UNSIGNED-PAYLOAD
+// -------------------
+func (u *UrlData) CanonicalRequestV4() []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString(u.HttpMethod)
+	buf.WriteString("\n")
+
+	buf.WriteString(gcsV4EscapePath(u.Path))
+	buf.WriteString("\n")
+
+	buf.WriteString(u.CanonicalQueryStringV4())
+	buf.WriteString("\n")
+
+	// Each header is followed by a new line, so an empty line separates the
+	// headers from the signed header names.
+	headers := u.CanonicalHeadersV4()
+	var keys []string
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		buf.WriteString(fmt.Sprintf("%s:%s\n", k, headers[k]))
+	}
+	buf.WriteString("\n")
+
+	buf.WriteString(u.SignedHeadersV4())
+	buf.WriteString("\n")
+
+	// The payload of a signed url is never known in advance
+	buf.WriteString("UNSIGNED-PAYLOAD")
+
+	return buf.Bytes()
+}
+
+// SigningStringV4 creates the string that is signed for a v4 signature:
+// see https://cloud.google.com/storage/docs/authentication/signatures#string-to-sign
+func (u *UrlData) SigningStringV4() []byte {
+	hash := sha256.Sum256(u.CanonicalRequestV4())
+
+	var buf bytes.Buffer
+	buf.WriteString(gcsV4SigningAlgorithm)
+	buf.WriteString("\n")
+	buf.WriteString(u.Date.UTC().Format(gcsV4DateTimeFormat))
+	buf.WriteString("\n")
+	buf.WriteString(gcsV4CredentialScope(u.Date))
+	buf.WriteString("\n")
+	buf.WriteString(hex.EncodeToString(hash[:]))
+
+	return buf.Bytes()
+}
+
+// SignedUrlV4 constructs a v4 signed URL a client can use to retrieve storage object
+func (u *UrlData) SignedUrlV4() (string, error) {
+	encodedSig, err := u.EncodedSignature()
+	if err != nil {
+		return "", err
+	}
+
+	// https://cloud.google.com/storage/docs/access-control/signing-urls-manually
+	var urlBuffer bytes.Buffer
+	urlBuffer.WriteString(gcsBaseUrl)
+	urlBuffer.WriteString(gcsV4EscapePath(u.Path))
+	urlBuffer.WriteString("?")
+	urlBuffer.WriteString(u.CanonicalQueryStringV4())
+	urlBuffer.WriteString("&X-Goog-Signature=")
+	urlBuffer.WriteString(encodedSig)
+
+	return urlBuffer.String(), nil
+}
+
+// gcsV4CredentialScope returns the scope a v4 signature is valid for
+func gcsV4CredentialScope(date time.Time) string {
+	return fmt.Sprintf("%s/auto/storage/goog4_request", date.UTC().Format("20060102"))
+}
+
+// gcsV4Escape percent-encodes every byte of s except the RFC 3986 unreserved
+// characters, as required by v4 signatures.
+func gcsV4Escape(s string) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~' {
+			buf.WriteByte(c)
+		} else {
+			buf.WriteString(fmt.Sprintf("%%%02X", c))
+		}
+	}
+	return buf.String()
+}
+
+// gcsV4EscapePath escapes each segment of an object path, keeping the slashes
+func gcsV4EscapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = gcsV4Escape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
 // SignString calculates the SHA256 signature of the input string
 func SignString(toSign []byte, cfg *jwt.Config) ([]byte, error) {
 	// Parse private key
@@ -1,6 +1,10 @@
 package google
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -71,3 +75,87 @@ func addRequiredFieldsToSchema(schema map[string]*schema.Schema, keys ...string)
 func addOptionalFieldsToSchema(schema map[string]*schema.Schema, keys ...string) {
 	fixDatasourceSchemaFlags(schema, false, keys...)
 }
+
+// computeListDataSourceSchema returns the schema for a data source that lists
+// compute resources, with the matching resources flattened into itemsKey using
+// itemSchema. locationKey is "zone" or "region" for zonal and regional
+// resources, and empty for global ones. A labels argument is only added for
+// resources that support labels.
+func computeListDataSourceSchema(itemsKey, locationKey string, withLabels bool, itemSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"project": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"filter": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"self_links": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		itemsKey: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: itemSchema,
+			},
+		},
+	}
+	if withLabels {
+		s["labels"] = &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+	}
+	if locationKey != "" {
+		s[locationKey] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		}
+	}
+	return s
+}
+
+// computeListFilter combines the filter expression and labels of a list data
+// source into a single filter for a compute list call.
+func computeListFilter(d *schema.ResourceData) string {
+	var exprs []string
+	if v, ok := d.GetOk("filter"); ok {
+		exprs = append(exprs, fmt.Sprintf("(%s)", v.(string)))
+	}
+
+	labels, _ := d.Get("labels").(map[string]interface{})
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		exprs = append(exprs, fmt.Sprintf("(labels.%s = %q)", k, labels[k].(string)))
+	}
+
+	return strings.Join(exprs, " ")
+}
+
+// datasourceListItem flattens a single item of a list data source. set is
+// given a ResourceData for the item schema, so the flattening code of the
+// singular data source can be reused, and the values it sets are returned as
+// a list element.
+func datasourceListItem(s map[string]*schema.Schema, set func(d *schema.ResourceData) error) (map[string]interface{}, error) {
+	d := (&schema.Resource{Schema: s}).Data(nil)
+	if err := set(d); err != nil {
+		return nil, err
+	}
+
+	item := make(map[string]interface{}, len(s))
+	for k := range s {
+		item[k] = d.Get(k)
+	}
+	return item, nil
+}
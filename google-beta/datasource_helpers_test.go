@@ -0,0 +1,82 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestComputeListFilter(t *testing.T) {
+	cases := map[string]struct {
+		Filter   string
+		Labels   map[string]interface{}
+		Expected string
+	}{
+		"nothing": {
+			Expected: "",
+		},
+		"filter only": {
+			Filter:   `name = "tf-test-*"`,
+			Expected: `(name = "tf-test-*")`,
+		},
+		"labels only": {
+			Labels: map[string]interface{}{
+				"team": "infra",
+				"env":  "prod",
+			},
+			Expected: `(labels.env = "prod") (labels.team = "infra")`,
+		},
+		"filter and labels": {
+			Filter: `status = "RUNNING"`,
+			Labels: map[string]interface{}{
+				"env": "prod",
+			},
+			Expected: `(status = "RUNNING") (labels.env = "prod")`,
+		},
+	}
+
+	for tn, tc := range cases {
+		raw := map[string]interface{}{}
+		if tc.Filter != "" {
+			raw["filter"] = tc.Filter
+		}
+		if tc.Labels != nil {
+			raw["labels"] = tc.Labels
+		}
+		d := schema.TestResourceDataRaw(t, computeListDataSourceSchema("items", "", true, map[string]*schema.Schema{}), raw)
+
+		if filter := computeListFilter(d); filter != tc.Expected {
+			t.Errorf("bad: %s, expected %q, got %q", tn, tc.Expected, filter)
+		}
+	}
+}
+
+func TestDatasourceListItem(t *testing.T) {
+	s := datasourceSchemaFromResourceSchema(map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"tags": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	})
+
+	item, err := datasourceListItem(s, func(d *schema.ResourceData) error {
+		d.Set("name", "foo")
+		d.Set("tags", []string{"a", "b"})
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if item["name"] != "foo" {
+		t.Errorf("expected name %q, got %q", "foo", item["name"])
+	}
+	if tags := item["tags"].([]interface{}); len(tags) != 2 || tags[0] != "a" || tags[1] != "b" {
+		t.Errorf("expected tags [a b], got %v", tags)
+	}
+}
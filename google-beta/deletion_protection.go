@@ -0,0 +1,120 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Stateful resources have a deletion_protection argument that's only stored in
+// state. Unlike google_compute_instance's, it isn't backed by the API: the
+// provider refuses to delete the resource while it's set.
+
+// checkDeletionProtection is called at the start of Delete.
+func checkDeletionProtection(d *schema.ResourceData, resourceType string) error {
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Cannot delete %s %q: deletion_protection is set to true. Set deletion_protection to false for this resource and run \"terraform apply\" before attempting to delete it.", resourceType, d.Id())
+	}
+	return nil
+}
+
+// deletionProtectionCustomizeDiff returns a CustomizeDiffFunc that fails the
+// plan when it would replace a resource with deletion protection, so that it's
+// caught before any other resource is changed. The value in state is used, the
+// same one Delete would see.
+//
+// Replacements forced by other CustomizeDiff funcs aren't visible here, but
+// are still stopped by checkDeletionProtection when the old resource is
+// deleted.
+//
+// The resource is passed as its constructor so it can be used in its own
+// definition.
+func deletionProtectionCustomizeDiff(resourceType string, resource func() *schema.Resource) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() == "" {
+			return nil
+		}
+
+		if protected, _ := diff.GetChange("deletion_protection"); !protected.(bool) {
+			return nil
+		}
+
+		if key, ok := forceNewFieldChanged(diff, "", resource().Schema); ok {
+			return fmt.Errorf("Cannot replace %s %q: deletion_protection is set to true and %q can't be changed in place. Set deletion_protection to false for this resource and run \"terraform apply\" before changing it.", resourceType, diff.Id(), key)
+		}
+		return nil
+	}
+}
+
+// forceNewFieldChanged returns the first ForceNew field, nested or not, that
+// changes in the diff.
+func forceNewFieldChanged(diff *schema.ResourceDiff, prefix string, s map[string]*schema.Schema) (string, bool) {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := s[k]
+		key := prefix + k
+		if !diff.HasChange(key) {
+			continue
+		}
+		if v.ForceNew {
+			return key, true
+		}
+
+		elem, ok := v.Elem.(*schema.Resource)
+		if !ok {
+			continue
+		}
+
+		switch v.Type {
+		case schema.TypeSet:
+			// Changing an element of a set replaces it, so any change to a set
+			// with ForceNew fields forces a new resource.
+			if schemaHasForceNew(elem.Schema) {
+				return key, true
+			}
+		case schema.TypeList:
+			o, n := diff.GetChange(key)
+			ol, _ := o.([]interface{})
+			nl, _ := n.([]interface{})
+			count := len(ol)
+			if l := len(nl); l > count {
+				count = l
+			}
+			for i := 0; i < count; i++ {
+				if nested, ok := forceNewFieldChanged(diff, fmt.Sprintf("%s.%d.", key, i), elem.Schema); ok {
+					return nested, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+func schemaHasForceNew(s map[string]*schema.Schema) bool {
+	for _, v := range s {
+		if v.ForceNew {
+			return true
+		}
+		if elem, ok := v.Elem.(*schema.Resource); ok && schemaHasForceNew(elem.Schema) {
+			return true
+		}
+	}
+	return false
+}
+
+// onlyDeletionProtectionChanged lets Update skip calling the API when the only
+// change is to deletion_protection.
+func onlyDeletionProtectionChanged(d *schema.ResourceData, s map[string]*schema.Schema) bool {
+	for k := range s {
+		if k != "deletion_protection" && d.HasChange(k) {
+			return false
+		}
+	}
+	return true
+}
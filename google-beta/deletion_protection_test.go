@@ -0,0 +1,172 @@
+package google
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testDeletionProtectionResource() *schema.Resource {
+	return &schema.Resource{
+		CustomizeDiff: deletionProtectionCustomizeDiff("google_test_resource", testDeletionProtectionResource),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tier": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func TestDeletionProtectionCustomizeDiff(t *testing.T) {
+	cases := map[string]struct {
+		State         map[string]string
+		Config        map[string]interface{}
+		ExpectedError string
+	}{
+		"create": {
+			Config: map[string]interface{}{
+				"name":                "foo",
+				"deletion_protection": true,
+			},
+		},
+		"in-place update of a protected resource": {
+			State: map[string]string{
+				"name":                "foo",
+				"description":         "old",
+				"deletion_protection": "true",
+			},
+			Config: map[string]interface{}{
+				"name":                "foo",
+				"description":         "new",
+				"deletion_protection": true,
+			},
+		},
+		"replacement of an unprotected resource": {
+			State: map[string]string{
+				"name":                "foo",
+				"deletion_protection": "false",
+			},
+			Config: map[string]interface{}{
+				"name": "bar",
+			},
+		},
+		"replacement of a protected resource": {
+			State: map[string]string{
+				"name":                "foo",
+				"deletion_protection": "true",
+			},
+			Config: map[string]interface{}{
+				"name":                "bar",
+				"deletion_protection": true,
+			},
+			ExpectedError: `"name" can't be changed in place`,
+		},
+		"replacement while removing protection": {
+			State: map[string]string{
+				"name":                "foo",
+				"deletion_protection": "true",
+			},
+			Config: map[string]interface{}{
+				"name": "bar",
+			},
+			ExpectedError: `"name" can't be changed in place`,
+		},
+		"nested in-place update of a protected resource": {
+			State: map[string]string{
+				"name":                "foo",
+				"settings.#":          "1",
+				"settings.0.tier":     "small",
+				"settings.0.zone":     "us-central1-a",
+				"deletion_protection": "true",
+			},
+			Config: map[string]interface{}{
+				"name": "foo",
+				"settings": []interface{}{
+					map[string]interface{}{
+						"tier": "large",
+						"zone": "us-central1-a",
+					},
+				},
+				"deletion_protection": true,
+			},
+		},
+		"nested replacement of a protected resource": {
+			State: map[string]string{
+				"name":                "foo",
+				"settings.#":          "1",
+				"settings.0.tier":     "small",
+				"settings.0.zone":     "us-central1-a",
+				"deletion_protection": "true",
+			},
+			Config: map[string]interface{}{
+				"name": "foo",
+				"settings": []interface{}{
+					map[string]interface{}{
+						"tier": "small",
+						"zone": "us-central1-b",
+					},
+				},
+				"deletion_protection": true,
+			},
+			ExpectedError: `"settings.0.zone" can't be changed in place`,
+		},
+	}
+
+	for tn, tc := range cases {
+		var state *terraform.InstanceState
+		if tc.State != nil {
+			state = &terraform.InstanceState{
+				ID:         "foo",
+				Attributes: tc.State,
+			}
+		}
+
+		raw, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("bad: %s, unexpected error: %s", tn, err)
+		}
+
+		_, err = testDeletionProtectionResource().Diff(state, terraform.NewResourceConfig(raw), nil)
+		if tc.ExpectedError == "" {
+			if err != nil {
+				t.Errorf("bad: %s, unexpected error: %s", tn, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+			t.Errorf("bad: %s, expected an error containing %q, got %v", tn, tc.ExpectedError, err)
+		}
+	}
+}
@@ -66,10 +66,28 @@ func ParseInstanceGroupFieldValue(instanceGroup string, d TerraformResourceData,
 	return parseZonalFieldValue("instanceGroups", instanceGroup, "project", "zone", d, config, false)
 }
 
+func ParseNetworkEndpointGroupFieldValue(networkEndpointGroup string, d TerraformResourceData, config *Config) (*ZonalFieldValue, error) {
+	return parseZonalFieldValue("networkEndpointGroups", networkEndpointGroup, "project", "zone", d, config, false)
+}
+
+// Backend groups are either instance groups or network endpoint groups, both
+// referenced by zonal self links. Only the latter are balanced per endpoint.
+func isNetworkEndpointGroupLink(group string) bool {
+	return regexp.MustCompile(fmt.Sprintf(zonalPartialLinkBasePattern, "networkEndpointGroups")).MatchString(group)
+}
+
 func ParseInstanceTemplateFieldValue(instanceTemplate string, d TerraformResourceData, config *Config) (*GlobalFieldValue, error) {
 	return parseGlobalFieldValue("instanceTemplates", instanceTemplate, "project", d, config, false)
 }
 
+func ParseNodeTemplateFieldValue(nodeTemplate string, d TerraformResourceData, config *Config) (*RegionalFieldValue, error) {
+	return parseRegionalFieldValue("nodeTemplates", nodeTemplate, "project", "region", "zone", d, config, false)
+}
+
+func ParseResourcePolicyFieldValue(resourcePolicy string, d TerraformResourceData, config *Config) (*RegionalFieldValue, error) {
+	return parseRegionalFieldValue("resourcePolicies", resourcePolicy, "project", "region", "zone", d, config, false)
+}
+
 func ParseSecurityPolicyFieldValue(securityPolicy string, d TerraformResourceData, config *Config) (*GlobalFieldValue, error) {
 	return parseGlobalFieldValue("securityPolicies", securityPolicy, "project", d, config, true)
 }
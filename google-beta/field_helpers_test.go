@@ -353,3 +353,20 @@ func TestParseRegionalFieldValue(t *testing.T) {
 		})
 	}
 }
+
+func TestIsNetworkEndpointGroupLink(t *testing.T) {
+	cases := map[string]bool{
+		"https://www.googleapis.com/compute/beta/projects/my-project/zones/us-central1-a/networkEndpointGroups/my-neg": true,
+		"projects/my-project/zones/us-central1-a/networkEndpointGroups/my-neg":                                         true,
+		"zones/us-central1-a/networkEndpointGroups/my-neg":                                                             true,
+		"https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instanceGroups/my-group":        false,
+		"projects/my-project/regions/us-central1/instanceGroups/my-group":                                              false,
+		"my-neg": false,
+	}
+
+	for group, expected := range cases {
+		if actual := isNetworkEndpointGroupLink(group); actual != expected {
+			t.Errorf("bad: %s, expected %t but got %t", group, expected, actual)
+		}
+	}
+}
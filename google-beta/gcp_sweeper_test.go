@@ -2,9 +2,13 @@ package google
 
 import (
 	"fmt"
+	"log"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
+	"google.golang.org/api/compute/v1"
 )
 
 func TestMain(m *testing.M) {
@@ -32,3 +36,180 @@ func sharedConfigForRegion(region string) (*Config, error) {
 
 	return conf, nil
 }
+
+// Acceptance tests name the resources they create with one of these prefixes.
+// Sweepers only delete resources that match, so anything else in the test
+// project is left alone. "tf_test" covers resources like BigQuery datasets
+// whose names can't contain dashes.
+var testSweepPrefixes = []string{"tf-test", "tf_test", "tfacc"}
+
+// isSweepableTestResource checks the name of a resource, given as a name, a
+// relative resource name or a self link, against testSweepPrefixes.
+func isSweepableTestResource(id string) bool {
+	name := GetResourceNameFromSelfLink(id)
+	for _, prefix := range testSweepPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// testSweeper describes how to clean up leaked test resources of one type.
+type testSweeper struct {
+	// Sweepers that have to run first, e.g. instances before the networks
+	// they're attached to.
+	Dependencies []string
+
+	// List returns the ids of all resources of this type in the region, in
+	// whatever form Delete accepts. The last segment of the id is the name
+	// checked against testSweepPrefixes.
+	List func(config *Config, region string) ([]string, error)
+
+	// Delete removes a single resource, waiting for it to be gone if other
+	// sweepers depend on it.
+	Delete func(config *Config, region, id string) error
+}
+
+// addTestSweeper registers a sweeper that deletes every test resource found
+// by s.List.
+func addTestSweeper(name string, s testSweeper) {
+	resource.AddTestSweepers(name, &resource.Sweeper{
+		Name:         name,
+		Dependencies: s.Dependencies,
+		F:            sweepTestResources(name, s),
+	})
+}
+
+func sweepTestResources(name string, s testSweeper) resource.SweeperFunc {
+	return func(region string) error {
+		config, err := sharedConfigForRegion(region)
+		if err != nil {
+			return fmt.Errorf("error getting shared config for region: %s", err)
+		}
+
+		err = config.loadAndValidate()
+		if err != nil {
+			log.Fatalf("error loading: %s", err)
+		}
+
+		ids, err := s.List(config, region)
+		if err != nil {
+			return fmt.Errorf("error listing resources for %s: %s", name, err)
+		}
+
+		var allErrors error
+		for _, id := range ids {
+			if !isSweepableTestResource(id) {
+				continue
+			}
+
+			log.Printf("[INFO] %s: deleting %s", name, id)
+			if err := s.Delete(config, region, id); err != nil {
+				allErrors = multierror.Append(allErrors, fmt.Errorf("Unable to delete %s: %s", id, err))
+			}
+		}
+
+		return allErrors
+	}
+}
+
+// listComputeSelfLinks returns the self links of the items of a compute list
+// url, following pagination.
+func listComputeSelfLinks(config *Config, url string) ([]string, error) {
+	selfLinks := make([]string, 0)
+	pageToken := ""
+	for {
+		pageUrl := url
+		if pageToken != "" {
+			pageUrl = fmt.Sprintf("%s?pageToken=%s", url, pageToken)
+		}
+
+		res, err := sendRequest(config, "GET", pageUrl, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		items, _ := res["items"].([]interface{})
+		for _, item := range items {
+			if selfLink, ok := item.(map[string]interface{})["selfLink"].(string); ok {
+				selfLinks = append(selfLinks, selfLink)
+			}
+		}
+
+		pageToken, _ = res["nextPageToken"].(string)
+		if pageToken == "" {
+			return selfLinks, nil
+		}
+	}
+}
+
+// listGlobalComputeResources lists a global compute collection, e.g. "networks".
+func listGlobalComputeResources(collection string) func(*Config, string) ([]string, error) {
+	return func(config *Config, region string) ([]string, error) {
+		return listComputeSelfLinks(config, fmt.Sprintf("%s%s/global/%s", config.clientCompute.BasePath, config.Project, collection))
+	}
+}
+
+// listRegionalComputeResources lists a regional compute collection, e.g. "subnetworks".
+func listRegionalComputeResources(collection string) func(*Config, string) ([]string, error) {
+	return func(config *Config, region string) ([]string, error) {
+		return listComputeSelfLinks(config, fmt.Sprintf("%s%s/regions/%s/%s", config.clientCompute.BasePath, config.Project, region, collection))
+	}
+}
+
+// listZonalComputeResources lists a zonal compute collection, e.g. "instances",
+// in every zone of the region.
+func listZonalComputeResources(collection string) func(*Config, string) ([]string, error) {
+	return func(config *Config, region string) ([]string, error) {
+		r, err := config.clientCompute.Regions.Get(config.Project, region).Do()
+		if err != nil {
+			return nil, err
+		}
+
+		selfLinks := make([]string, 0)
+		for _, zone := range r.Zones {
+			zoneLinks, err := listComputeSelfLinks(config, fmt.Sprintf("%s%s/zones/%s/%s", config.clientCompute.BasePath, config.Project, GetResourceNameFromSelfLink(zone), collection))
+			if err != nil {
+				return nil, err
+			}
+			selfLinks = append(selfLinks, zoneLinks...)
+		}
+		return selfLinks, nil
+	}
+}
+
+// sweepComputeResource deletes a compute resource by its self link and waits
+// for the operation to finish.
+func sweepComputeResource(config *Config, region, selfLink string) error {
+	res, err := sendRequest(config, "DELETE", selfLink, nil)
+	if err != nil {
+		return err
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	return computeOperationWaitTime(config.clientCompute, op, config.Project, fmt.Sprintf("Sweeping %s", GetResourceNameFromSelfLink(selfLink)), 10)
+}
+
+func TestIsSweepableTestResource(t *testing.T) {
+	cases := map[string]bool{
+		"tf-test-abc123":  true,
+		"tf_test_dataset": true,
+		"tfacc-network":   true,
+		"https://www.googleapis.com/compute/v1/projects/p/global/networks/tf-test-abc123": true,
+		"projects/p/locations/global/keyRings/tf-test-ring/cryptoKeys/tf-test-key":        true,
+		"projects/p/serviceAccounts/tf-test-sa@p.iam.gserviceaccount.com":                 true,
+		"default": false,
+		"https://www.googleapis.com/compute/v1/projects/tf-test-project/global/networks/vm": false,
+	}
+
+	for id, expected := range cases {
+		if got := isSweepableTestResource(id); got != expected {
+			t.Errorf("isSweepableTestResource(%q) = %t, expected %t", id, got, expected)
+		}
+	}
+}
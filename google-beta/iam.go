@@ -3,11 +3,14 @@ package google
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/iam/v1"
 )
 
 // The ResourceIamUpdater interface is implemented for each GCP resource supporting IAM policy.
@@ -154,3 +157,151 @@ func rolesToMembersMap(bindings []*cloudresourcemanager.Binding) map[string]map[
 	}
 	return bm
 }
+
+// Returns the IAM member string ("user:..." or "serviceAccount:...") for an account email.
+func iamMemberForEmail(email string) string {
+	if strings.HasSuffix(email, ".gserviceaccount.com") {
+		return "serviceAccount:" + email
+	}
+	return "user:" + email
+}
+
+// Returns the roles granted directly to member by the given bindings.
+func rolesForMember(bindings []*cloudresourcemanager.Binding, member string) []string {
+	roles := make([]string, 0)
+	for role, members := range rolesToMembersMap(bindings) {
+		if members[member] {
+			roles = append(roles, role)
+		}
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// Returns whether any of the given predefined or custom roles includes permission.
+func rolesIncludePermission(config *Config, roles []string, permission string) (bool, error) {
+	for _, name := range roles {
+		var role *iam.Role
+		var err error
+		switch {
+		case strings.HasPrefix(name, "projects/"):
+			role, err = config.clientIAM.Projects.Roles.Get(name).Do()
+		case strings.HasPrefix(name, "organizations/"):
+			role, err = config.clientIAM.Organizations.Roles.Get(name).Do()
+		default:
+			role, err = config.clientIAM.Roles.Get(name).Do()
+		}
+		if err != nil {
+			return false, errwrap.Wrapf(fmt.Sprintf("Error reading role %q: {{err}}", name), err)
+		}
+
+		for _, p := range role.IncludedPermissions {
+			if p == permission {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// Guards against replacing an authoritative policy with one that strips the
+// identity Terraform runs as of permission on the resource. Only bindings made
+// directly to that identity are considered: access inherited from a parent or
+// granted through a group is not changed by writing this policy. If the
+// credentials don't expose an email address (e.g. an access_token without the
+// userinfo.email scope) the check is skipped with a warning.
+func checkIamPolicySelfLockout(config *Config, updater ResourceIamUpdater, permission string, policy *cloudresourcemanager.Policy) error {
+	email, err := config.getCallerIdentity()
+	if err == errCallerIdentityNoEmail {
+		log.Printf("[WARN] Unable to determine the identity Terraform is running as, skipping the self-lockout check for %s: %s", updater.DescribeResource(), err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to determine the identity Terraform is running as to check %s for self-lockout: %s. Set allow_self_lockout to true to skip this check.", updater.DescribeResource(), err)
+	}
+	member := iamMemberForEmail(email)
+
+	existing, err := updater.GetResourceIamPolicy()
+	if err != nil {
+		return err
+	}
+
+	hasPermission, err := rolesIncludePermission(config, rolesForMember(existing.Bindings, member), permission)
+	if err != nil {
+		return err
+	}
+	if !hasPermission {
+		return nil
+	}
+
+	keepsPermission, err := rolesIncludePermission(config, rolesForMember(policy.Bindings, member), permission)
+	if err != nil {
+		return err
+	}
+	if keepsPermission {
+		return nil
+	}
+
+	return fmt.Errorf("Refusing to update the IAM policy for %s: %q would lose %s and could no longer manage this policy. Set allow_self_lockout to true to apply it anyway.", updater.DescribeResource(), member, permission)
+}
+
+type customRoleParentFunc func(diff *schema.ResourceDiff, config *Config) (string, error)
+
+// Returns a CustomizeDiff func that rejects custom role permissions that don't exist, or
+// that can't be used in custom roles, at the parent (e.g. "projects/foo") returned by parentFunc.
+// An empty parent means it isn't known until apply and the check is skipped.
+func customRolePermissionsCustomizeDiff(parentFunc customRoleParentFunc) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.HasChange("permissions") || !diff.NewValueKnown("permissions") {
+			return nil
+		}
+
+		config := meta.(*Config)
+		parent, err := parentFunc(diff, config)
+		if err != nil {
+			return err
+		}
+		if parent == "" {
+			return nil
+		}
+
+		testable, err := queryTestablePermissions(config, "//cloudresourcemanager.googleapis.com/"+parent)
+		if err != nil {
+			return err
+		}
+
+		return validateCustomRolePermissions(parent, convertStringSet(diff.Get("permissions").(*schema.Set)), testable)
+	}
+}
+
+func validateCustomRolePermissions(parent string, permissions []string, testable []*iam.Permission) error {
+	known := make(map[string]*iam.Permission, len(testable))
+	for _, p := range testable {
+		known[p.Name] = p
+	}
+
+	var unknown, unsupported []string
+	for _, name := range permissions {
+		p, ok := known[name]
+		if !ok {
+			unknown = append(unknown, name)
+		} else if customRolesSupportLevel(p) == "NOT_SUPPORTED" {
+			unsupported = append(unsupported, name)
+		}
+	}
+	sort.Strings(unknown)
+	sort.Strings(unsupported)
+
+	var errs []string
+	if len(unknown) > 0 {
+		errs = append(errs, fmt.Sprintf("permissions do not exist or cannot be granted on %s: %s", parent, strings.Join(unknown, ", ")))
+	}
+	if len(unsupported) > 0 {
+		errs = append(errs, fmt.Sprintf("permissions are not supported in custom roles: %s", strings.Join(unsupported, ", ")))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("Invalid custom role permissions: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package google
 
 import (
 	"fmt"
+	"strings"
+
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/cloudresourcemanager/v1"
@@ -30,6 +32,14 @@ func NewStorageBucketIamUpdater(d *schema.ResourceData, config *Config) (Resourc
 	}, nil
 }
 
+// Accepts the bucket name, optionally in the "b/{bucket}" form used by the storage API.
+func StorageBucketIdParseFunc(d *schema.ResourceData, _ *Config) error {
+	bucket := strings.TrimPrefix(d.Id(), "b/")
+	d.Set("bucket", bucket)
+	d.SetId(bucket)
+	return nil
+}
+
 func (u *StorageBucketIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
 	p, err := u.Config.clientStorage.Buckets.GetIamPolicy(u.bucket).Do()
 	if err != nil {
@@ -0,0 +1,403 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/dns/v1"
+	"google.golang.org/api/iam/v1"
+	"google.golang.org/api/storage/v1"
+)
+
+// ImportableResource is an existing resource that `terraform import` can bring
+// under management.
+type ImportableResource struct {
+	// The resource type, e.g. google_compute_instance.
+	Type string
+	// A short name for the resource, used to name it in configuration.
+	Name string
+	// An id in one of the formats the resource's importer accepts.
+	ImportId string
+}
+
+type importableResourceLister func(config *Config, project string) ([]ImportableResource, error)
+
+var importableResourceListers = map[string]importableResourceLister{
+	"google_compute_address":         listImportableComputeAddresses,
+	"google_compute_disk":            listImportableComputeDisks,
+	"google_compute_firewall":        listImportableComputeFirewalls,
+	"google_compute_global_address":  listImportableComputeGlobalAddresses,
+	"google_compute_instance":        listImportableComputeInstances,
+	"google_compute_network":         listImportableComputeNetworks,
+	"google_compute_router":          listImportableComputeRouters,
+	"google_compute_subnetwork":      listImportableComputeSubnetworks,
+	"google_container_cluster":       listImportableContainerClusters,
+	"google_dns_managed_zone":        listImportableDnsManagedZones,
+	"google_dns_record_set":          listImportableDnsRecordSets,
+	"google_project_iam_binding":     listImportableProjectIamBindings,
+	"google_project_iam_custom_role": listImportableProjectIamCustomRoles,
+	"google_service_account":         listImportableServiceAccounts,
+	"google_sql_database":            listImportableSqlDatabases,
+	"google_sql_database_instance":   listImportableSqlDatabaseInstances,
+	"google_storage_bucket":          listImportableStorageBuckets,
+}
+
+// ImportableResourceTypes returns the resource types ListImportableResources can find.
+func ImportableResourceTypes() []string {
+	types := make([]string, 0, len(importableResourceListers))
+	for t := range importableResourceListers {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// ListImportableResources finds the existing resources of the given types in
+// the provider's project. Every supported type is listed if types is empty.
+func (c *Config) ListImportableResources(types []string) ([]ImportableResource, error) {
+	if len(types) == 0 {
+		types = ImportableResourceTypes()
+	}
+
+	resources := make([]ImportableResource, 0)
+	for _, t := range types {
+		lister, ok := importableResourceListers[t]
+		if !ok {
+			return nil, fmt.Errorf("Listing resources of type %q is not supported. Supported types are %v", t, ImportableResourceTypes())
+		}
+
+		found, err := lister(c, c.Project)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing %s resources: %s", t, err)
+		}
+		resources = append(resources, found...)
+	}
+
+	return resources, nil
+}
+
+func listImportableComputeNetworks(config *Config, project string) ([]ImportableResource, error) {
+	resources := make([]ImportableResource, 0)
+	err := config.clientCompute.Networks.List(project).Pages(context.Background(), func(res *compute.NetworkList) error {
+		for _, n := range res.Items {
+			resources = append(resources, ImportableResource{
+				Type:     "google_compute_network",
+				Name:     n.Name,
+				ImportId: n.Name,
+			})
+		}
+		return nil
+	})
+	return resources, err
+}
+
+func listImportableComputeSubnetworks(config *Config, project string) ([]ImportableResource, error) {
+	resources := make([]ImportableResource, 0)
+	err := config.clientCompute.Subnetworks.AggregatedList(project).Pages(context.Background(), func(res *compute.SubnetworkAggregatedList) error {
+		for _, scoped := range res.Items {
+			for _, s := range scoped.Subnetworks {
+				resources = append(resources, ImportableResource{
+					Type:     "google_compute_subnetwork",
+					Name:     s.Name,
+					ImportId: fmt.Sprintf("projects/%s/regions/%s/subnetworks/%s", project, GetResourceNameFromSelfLink(s.Region), s.Name),
+				})
+			}
+		}
+		return nil
+	})
+	return resources, err
+}
+
+func listImportableComputeFirewalls(config *Config, project string) ([]ImportableResource, error) {
+	resources := make([]ImportableResource, 0)
+	err := config.clientCompute.Firewalls.List(project).Pages(context.Background(), func(res *compute.FirewallList) error {
+		for _, f := range res.Items {
+			resources = append(resources, ImportableResource{
+				Type:     "google_compute_firewall",
+				Name:     f.Name,
+				ImportId: fmt.Sprintf("projects/%s/global/firewalls/%s", project, f.Name),
+			})
+		}
+		return nil
+	})
+	return resources, err
+}
+
+func listImportableComputeAddresses(config *Config, project string) ([]ImportableResource, error) {
+	resources := make([]ImportableResource, 0)
+	err := config.clientCompute.Addresses.AggregatedList(project).Pages(context.Background(), func(res *compute.AddressAggregatedList) error {
+		for _, scoped := range res.Items {
+			for _, a := range scoped.Addresses {
+				// Global addresses show up in the aggregated list too.
+				if a.Region == "" {
+					continue
+				}
+				resources = append(resources, ImportableResource{
+					Type:     "google_compute_address",
+					Name:     a.Name,
+					ImportId: fmt.Sprintf("projects/%s/regions/%s/addresses/%s", project, GetResourceNameFromSelfLink(a.Region), a.Name),
+				})
+			}
+		}
+		return nil
+	})
+	return resources, err
+}
+
+func listImportableComputeGlobalAddresses(config *Config, project string) ([]ImportableResource, error) {
+	resources := make([]ImportableResource, 0)
+	err := config.clientCompute.GlobalAddresses.List(project).Pages(context.Background(), func(res *compute.AddressList) error {
+		for _, a := range res.Items {
+			resources = append(resources, ImportableResource{
+				Type:     "google_compute_global_address",
+				Name:     a.Name,
+				ImportId: fmt.Sprintf("projects/%s/global/addresses/%s", project, a.Name),
+			})
+		}
+		return nil
+	})
+	return resources, err
+}
+
+func listImportableComputeInstances(config *Config, project string) ([]ImportableResource, error) {
+	resources := make([]ImportableResource, 0)
+	err := config.clientCompute.Instances.AggregatedList(project).Pages(context.Background(), func(res *compute.InstanceAggregatedList) error {
+		for _, scoped := range res.Items {
+			for _, i := range scoped.Instances {
+				resources = append(resources, ImportableResource{
+					Type:     "google_compute_instance",
+					Name:     i.Name,
+					ImportId: fmt.Sprintf("%s/%s/%s", project, GetResourceNameFromSelfLink(i.Zone), i.Name),
+				})
+			}
+		}
+		return nil
+	})
+	return resources, err
+}
+
+func listImportableComputeDisks(config *Config, project string) ([]ImportableResource, error) {
+	resources := make([]ImportableResource, 0)
+	err := config.clientCompute.Disks.AggregatedList(project).Pages(context.Background(), func(res *compute.DiskAggregatedList) error {
+		for _, scoped := range res.Items {
+			for _, d := range scoped.Disks {
+				// Regional disks are a separate resource type.
+				if d.Zone == "" {
+					continue
+				}
+				resources = append(resources, ImportableResource{
+					Type:     "google_compute_disk",
+					Name:     d.Name,
+					ImportId: fmt.Sprintf("projects/%s/zones/%s/disks/%s", project, GetResourceNameFromSelfLink(d.Zone), d.Name),
+				})
+			}
+		}
+		return nil
+	})
+	return resources, err
+}
+
+func listImportableComputeRouters(config *Config, project string) ([]ImportableResource, error) {
+	resources := make([]ImportableResource, 0)
+	err := config.clientCompute.Routers.AggregatedList(project).Pages(context.Background(), func(res *compute.RouterAggregatedList) error {
+		for _, scoped := range res.Items {
+			for _, r := range scoped.Routers {
+				resources = append(resources, ImportableResource{
+					Type:     "google_compute_router",
+					Name:     r.Name,
+					ImportId: fmt.Sprintf("projects/%s/regions/%s/routers/%s", project, GetResourceNameFromSelfLink(r.Region), r.Name),
+				})
+			}
+		}
+		return nil
+	})
+	return resources, err
+}
+
+func listImportableContainerClusters(config *Config, project string) ([]ImportableResource, error) {
+	res, err := config.clientContainer.Projects.Locations.Clusters.List(fmt.Sprintf("projects/%s/locations/-", project)).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]ImportableResource, 0, len(res.Clusters))
+	for _, c := range res.Clusters {
+		resources = append(resources, ImportableResource{
+			Type:     "google_container_cluster",
+			Name:     c.Name,
+			ImportId: fmt.Sprintf("%s/%s/%s", project, c.Location, c.Name),
+		})
+	}
+	return resources, nil
+}
+
+func listImportableSqlDatabaseInstances(config *Config, project string) ([]ImportableResource, error) {
+	res, err := config.clientSqlAdmin.Instances.List(project).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]ImportableResource, 0, len(res.Items))
+	for _, i := range res.Items {
+		resources = append(resources, ImportableResource{
+			Type:     "google_sql_database_instance",
+			Name:     i.Name,
+			ImportId: fmt.Sprintf("projects/%s/instances/%s", project, i.Name),
+		})
+	}
+	return resources, nil
+}
+
+// Databases that Cloud SQL creates itself, which aren't worth managing.
+var sqlSystemDatabases = map[string]bool{
+	"information_schema": true,
+	"mysql":              true,
+	"performance_schema": true,
+	"sys":                true,
+	"postgres":           true,
+}
+
+func listImportableSqlDatabases(config *Config, project string) ([]ImportableResource, error) {
+	instances, err := config.clientSqlAdmin.Instances.List(project).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]ImportableResource, 0)
+	for _, i := range instances.Items {
+		res, err := config.clientSqlAdmin.Databases.List(project, i.Name).Do()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, db := range res.Items {
+			if sqlSystemDatabases[db.Name] {
+				continue
+			}
+			resources = append(resources, ImportableResource{
+				Type:     "google_sql_database",
+				Name:     fmt.Sprintf("%s-%s", i.Name, db.Name),
+				ImportId: fmt.Sprintf("projects/%s/instances/%s/databases/%s", project, i.Name, db.Name),
+			})
+		}
+	}
+	return resources, nil
+}
+
+func listImportableStorageBuckets(config *Config, project string) ([]ImportableResource, error) {
+	resources := make([]ImportableResource, 0)
+	err := config.clientStorage.Buckets.List(project).Pages(context.Background(), func(res *storage.Buckets) error {
+		for _, b := range res.Items {
+			resources = append(resources, ImportableResource{
+				Type:     "google_storage_bucket",
+				Name:     b.Name,
+				ImportId: b.Name,
+			})
+		}
+		return nil
+	})
+	return resources, err
+}
+
+func listImportableServiceAccounts(config *Config, project string) ([]ImportableResource, error) {
+	resources := make([]ImportableResource, 0)
+	err := config.clientIAM.Projects.ServiceAccounts.List("projects/"+project).Pages(context.Background(), func(res *iam.ListServiceAccountsResponse) error {
+		for _, sa := range res.Accounts {
+			resources = append(resources, ImportableResource{
+				Type:     "google_service_account",
+				Name:     strings.Split(sa.Email, "@")[0],
+				ImportId: fmt.Sprintf("projects/%s/serviceAccounts/%s", project, sa.Email),
+			})
+		}
+		return nil
+	})
+	return resources, err
+}
+
+func listImportableProjectIamBindings(config *Config, project string) ([]ImportableResource, error) {
+	policy, err := config.clientResourceManager.Projects.GetIamPolicy(project, &cloudresourcemanager.GetIamPolicyRequest{}).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]ImportableResource, 0, len(policy.Bindings))
+	for _, b := range mergeBindings(policy.Bindings) {
+		resources = append(resources, ImportableResource{
+			Type:     "google_project_iam_binding",
+			Name:     GetResourceNameFromSelfLink(b.Role),
+			ImportId: fmt.Sprintf("%s %s", project, b.Role),
+		})
+	}
+	return resources, nil
+}
+
+func listImportableProjectIamCustomRoles(config *Config, project string) ([]ImportableResource, error) {
+	resources := make([]ImportableResource, 0)
+	err := config.clientIAM.Projects.Roles.List("projects/"+project).Pages(context.Background(), func(res *iam.ListRolesResponse) error {
+		for _, r := range res.Roles {
+			if r.Deleted {
+				continue
+			}
+			resources = append(resources, ImportableResource{
+				Type:     "google_project_iam_custom_role",
+				Name:     GetResourceNameFromSelfLink(r.Name),
+				ImportId: r.Name,
+			})
+		}
+		return nil
+	})
+	return resources, err
+}
+
+func listImportableDnsManagedZones(config *Config, project string) ([]ImportableResource, error) {
+	resources := make([]ImportableResource, 0)
+	err := config.clientDns.ManagedZones.List(project).Pages(context.Background(), func(res *dns.ManagedZonesListResponse) error {
+		for _, z := range res.ManagedZones {
+			resources = append(resources, ImportableResource{
+				Type:     "google_dns_managed_zone",
+				Name:     z.Name,
+				ImportId: fmt.Sprintf("projects/%s/managedZones/%s", project, z.Name),
+			})
+		}
+		return nil
+	})
+	return resources, err
+}
+
+func listImportableDnsRecordSets(config *Config, project string) ([]ImportableResource, error) {
+	zones, err := listImportableDnsManagedZones(config, project)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]ImportableResource, 0)
+	for _, zone := range zones {
+		z, err := config.clientDns.ManagedZones.Get(project, zone.Name).Do()
+		if err != nil {
+			return nil, err
+		}
+
+		err = config.clientDns.ResourceRecordSets.List(project, z.Name).Pages(context.Background(), func(res *dns.ResourceRecordSetsListResponse) error {
+			for _, rrset := range res.Rrsets {
+				// The zone's own SOA and NS records are managed by Cloud DNS.
+				if rrset.Name == z.DnsName && (rrset.Type == "SOA" || rrset.Type == "NS") {
+					continue
+				}
+				resources = append(resources, ImportableResource{
+					Type:     "google_dns_record_set",
+					Name:     fmt.Sprintf("%s-%s-%s", z.Name, strings.TrimSuffix(rrset.Name, "."), strings.ToLower(rrset.Type)),
+					ImportId: fmt.Sprintf("%s/%s/%s", z.Name, rrset.Name, rrset.Type),
+				})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
@@ -0,0 +1,21 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestImportableResourceTypes_areImportable(t *testing.T) {
+	resources := Provider().(*schema.Provider).ResourcesMap
+	for _, typ := range ImportableResourceTypes() {
+		r, ok := resources[typ]
+		if !ok {
+			t.Errorf("%s is listed as importable but isn't a resource of the provider", typ)
+			continue
+		}
+		if r.Importer == nil {
+			t.Errorf("%s is listed as importable but has no importer", typ)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -58,46 +59,85 @@ func Provider() terraform.ResourceProvider {
 					"CLOUDSDK_COMPUTE_ZONE",
 				}, nil),
 			},
+
+			"quota_check": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{computeQuotaCheckWarn, computeQuotaCheckError}, false),
+			},
+
+			"quota_warnings": &schema.Schema{
+				Type:       schema.TypeBool,
+				Optional:   true,
+				Default:    false,
+				Deprecated: "Use quota_check = \"warn\" instead.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"google_active_folder":                   dataSourceGoogleActiveFolder(),
-			"google_billing_account":                 dataSourceGoogleBillingAccount(),
-			"google_dns_managed_zone":                dataSourceDnsManagedZone(),
-			"google_client_config":                   dataSourceGoogleClientConfig(),
-			"google_cloudfunctions_function":         dataSourceGoogleCloudFunctionsFunction(),
-			"google_compute_address":                 dataSourceGoogleComputeAddress(),
-			"google_compute_backend_service":         dataSourceGoogleComputeBackendService(),
-			"google_compute_default_service_account": dataSourceGoogleComputeDefaultServiceAccount(),
-			"google_compute_forwarding_rule":         dataSourceGoogleComputeForwardingRule(),
-			"google_compute_image":                   dataSourceGoogleComputeImage(),
-			"google_compute_instance":                dataSourceGoogleComputeInstance(),
-			"google_compute_instance_group":          dataSourceGoogleComputeInstanceGroup(),
-			"google_compute_global_address":          dataSourceGoogleComputeGlobalAddress(),
-			"google_compute_lb_ip_ranges":            dataSourceGoogleComputeLbIpRanges(),
-			"google_compute_network":                 dataSourceGoogleComputeNetwork(),
-			"google_compute_regions":                 dataSourceGoogleComputeRegions(),
-			"google_compute_region_instance_group":   dataSourceGoogleComputeRegionInstanceGroup(),
-			"google_compute_subnetwork":              dataSourceGoogleComputeSubnetwork(),
-			"google_compute_zones":                   dataSourceGoogleComputeZones(),
-			"google_compute_vpn_gateway":             dataSourceGoogleComputeVpnGateway(),
-			"google_compute_ssl_policy":              dataSourceGoogleComputeSslPolicy(),
-			"google_container_cluster":               dataSourceGoogleContainerCluster(),
-			"google_container_engine_versions":       dataSourceGoogleContainerEngineVersions(),
-			"google_container_registry_repository":   dataSourceGoogleContainerRepo(),
-			"google_container_registry_image":        dataSourceGoogleContainerImage(),
-			"google_iam_policy":                      dataSourceGoogleIamPolicy(),
-			"google_iam_role":                        dataSourceGoogleIamRole(),
-			"google_kms_secret":                      dataSourceGoogleKmsSecret(),
-			"google_folder":                          dataSourceGoogleFolder(),
-			"google_netblock_ip_ranges":              dataSourceGoogleNetblockIpRanges(),
-			"google_organization":                    dataSourceGoogleOrganization(),
-			"google_project":                         dataSourceGoogleProject(),
-			"google_project_services":                dataSourceGoogleProjectServices(),
-			"google_service_account":                 dataSourceGoogleServiceAccount(),
-			"google_service_account_key":             dataSourceGoogleServiceAccountKey(),
-			"google_storage_object_signed_url":       dataSourceGoogleSignedUrl(),
-			"google_storage_project_service_account": dataSourceGoogleStorageProjectServiceAccount(),
+			"google_active_folder":                     dataSourceGoogleActiveFolder(),
+			"google_billing_account":                   dataSourceGoogleBillingAccount(),
+			"google_billing_account_iam_policy":        DataSourceIamPolicy(IamBillingAccountSchema, NewBillingAccountIamUpdater),
+			"google_dns_managed_zone":                  dataSourceDnsManagedZone(),
+			"google_client_config":                     dataSourceGoogleClientConfig(),
+			"google_cloud_asset_resources":             dataSourceGoogleCloudAssetResources(),
+			"google_cloudfunctions_function":           dataSourceGoogleCloudFunctionsFunction(),
+			"google_compute_address":                   dataSourceGoogleComputeAddress(),
+			"google_compute_addresses":                 dataSourceGoogleComputeAddresses(),
+			"google_compute_backend_service":           dataSourceGoogleComputeBackendService(),
+			"google_compute_cdn_signed_url":            dataSourceGoogleComputeCdnSignedUrl(),
+			"google_compute_default_service_account":   dataSourceGoogleComputeDefaultServiceAccount(),
+			"google_compute_disks":                     dataSourceGoogleComputeDisks(),
+			"google_compute_forwarding_rule":           dataSourceGoogleComputeForwardingRule(),
+			"google_compute_image":                     dataSourceGoogleComputeImage(),
+			"google_compute_instance":                  dataSourceGoogleComputeInstance(),
+			"google_compute_instance_group":            dataSourceGoogleComputeInstanceGroup(),
+			"google_compute_instances":                 dataSourceGoogleComputeInstances(),
+			"google_compute_global_address":            dataSourceGoogleComputeGlobalAddress(),
+			"google_compute_lb_ip_ranges":              dataSourceGoogleComputeLbIpRanges(),
+			"google_compute_network":                   dataSourceGoogleComputeNetwork(),
+			"google_compute_networks":                  dataSourceGoogleComputeNetworks(),
+			"google_compute_project_quotas":            dataSourceGoogleComputeProjectQuotas(),
+			"google_compute_region_quotas":             dataSourceGoogleComputeRegionQuotas(),
+			"google_compute_regions":                   dataSourceGoogleComputeRegions(),
+			"google_compute_region_instance_group":     dataSourceGoogleComputeRegionInstanceGroup(),
+			"google_compute_subnetwork":                dataSourceGoogleComputeSubnetwork(),
+			"google_compute_subnetwork_iam_policy":     DataSourceIamPolicy(IamComputeSubnetworkSchema, NewComputeSubnetworkIamUpdater),
+			"google_compute_subnetworks":               dataSourceGoogleComputeSubnetworks(),
+			"google_compute_zones":                     dataSourceGoogleComputeZones(),
+			"google_compute_vpn_gateway":               dataSourceGoogleComputeVpnGateway(),
+			"google_compute_ssl_policy":                dataSourceGoogleComputeSslPolicy(),
+			"google_container_cluster":                 dataSourceGoogleContainerCluster(),
+			"google_container_engine_versions":         dataSourceGoogleContainerEngineVersions(),
+			"google_container_registry_repository":     dataSourceGoogleContainerRepo(),
+			"google_container_registry_image":          dataSourceGoogleContainerImage(),
+			"google_iam_policy":                        dataSourceGoogleIamPolicy(),
+			"google_iam_role":                          dataSourceGoogleIamRole(),
+			"google_iam_testable_permissions":          dataSourceGoogleIamTestablePermissions(),
+			"google_kms_secret":                        dataSourceGoogleKmsSecret(),
+			"google_kms_crypto_key_iam_policy":         DataSourceIamPolicy(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater),
+			"google_kms_key_ring_iam_policy":           DataSourceIamPolicy(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater),
+			"google_folder":                            dataSourceGoogleFolder(),
+			"google_folder_iam_policy":                 DataSourceIamPolicy(IamFolderSchema, NewFolderIamUpdater),
+			"google_folders":                           dataSourceGoogleFolders(),
+			"google_netblock_ip_ranges":                dataSourceGoogleNetblockIpRanges(),
+			"google_organization":                      dataSourceGoogleOrganization(),
+			"google_organization_iam_policy":           DataSourceIamPolicy(IamOrganizationSchema, NewOrganizationIamUpdater),
+			"google_project":                           dataSourceGoogleProject(),
+			"google_project_iam_policy":                DataSourceIamPolicy(IamProjectSchema, NewProjectIamUpdater),
+			"google_project_services":                  dataSourceGoogleProjectServices(),
+			"google_projects":                          dataSourceGoogleProjects(),
+			"google_pubsub_subscription_iam_policy":    DataSourceIamPolicy(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater),
+			"google_pubsub_topic_iam_policy":           DataSourceIamPolicy(IamPubsubTopicSchema, NewPubsubTopicIamUpdater),
+			"google_service_account":                   dataSourceGoogleServiceAccount(),
+			"google_service_account_iam_policy":        DataSourceIamPolicy(IamServiceAccountSchema, NewServiceAccountIamUpdater),
+			"google_service_account_key":               dataSourceGoogleServiceAccountKey(),
+			"google_spanner_database_iam_policy":       DataSourceIamPolicy(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater),
+			"google_spanner_instance_iam_policy":       DataSourceIamPolicy(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater),
+			"google_storage_bucket_iam_policy":         DataSourceIamPolicy(IamStorageBucketSchema, NewStorageBucketIamUpdater),
+			"google_storage_object_signed_post_policy": dataSourceGoogleSignedPostPolicy(),
+			"google_storage_object_signed_url":         dataSourceGoogleSignedUrl(),
+			"google_storage_project_service_account":   dataSourceGoogleStorageProjectServiceAccount(),
 		},
 
 		ResourcesMap: mergeResourceMaps(
@@ -114,139 +154,153 @@ func Provider() terraform.ResourceProvider {
 			GeneratedStorageResourcesMap,
 			GeneratedMonitoringResourcesMap,
 			map[string]*schema.Resource{
-				"google_app_engine_application":                resourceAppEngineApplication(),
-				"google_bigquery_dataset":                      resourceBigQueryDataset(),
-				"google_bigquery_table":                        resourceBigQueryTable(),
-				"google_bigtable_instance":                     resourceBigtableInstance(),
-				"google_bigtable_table":                        resourceBigtableTable(),
-				"google_billing_account_iam_binding":           ResourceIamBindingWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
-				"google_billing_account_iam_member":            ResourceIamMemberWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
-				"google_billing_account_iam_policy":            ResourceIamPolicyWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
-				"google_cloudbuild_trigger":                    resourceCloudBuildTrigger(),
-				"google_cloudfunctions_function":               resourceCloudFunctionsFunction(),
-				"google_cloudiot_registry":                     resourceCloudIoTRegistry(),
-				"google_composer_environment":                  resourceComposerEnvironment(),
-				"google_compute_autoscaler":                    resourceComputeAutoscaler(),
-				"google_compute_address":                       resourceComputeAddress(),
-				"google_compute_attached_disk":                 resourceComputeAttachedDisk(),
-				"google_compute_backend_service":               resourceComputeBackendService(),
-				"google_compute_disk":                          resourceComputeDisk(),
-				"google_compute_snapshot":                      resourceComputeSnapshot(),
-				"google_compute_firewall":                      resourceComputeFirewall(),
-				"google_compute_forwarding_rule":               resourceComputeForwardingRule(),
-				"google_compute_global_forwarding_rule":        resourceComputeGlobalForwardingRule(),
-				"google_compute_health_check":                  resourceComputeHealthCheck(),
-				"google_compute_image":                         resourceComputeImage(),
-				"google_compute_instance":                      resourceComputeInstance(),
-				"google_compute_instance_from_template":        resourceComputeInstanceFromTemplate(),
-				"google_compute_instance_group":                resourceComputeInstanceGroup(),
-				"google_compute_instance_group_manager":        resourceComputeInstanceGroupManager(),
-				"google_compute_instance_template":             resourceComputeInstanceTemplate(),
-				"google_compute_network":                       resourceComputeNetwork(),
-				"google_compute_network_peering":               resourceComputeNetworkPeering(),
-				"google_compute_project_metadata":              resourceComputeProjectMetadata(),
-				"google_compute_project_metadata_item":         resourceComputeProjectMetadataItem(),
-				"google_compute_region_autoscaler":             resourceComputeRegionAutoscaler(),
-				"google_compute_region_backend_service":        resourceComputeRegionBackendService(),
-				"google_compute_region_instance_group_manager": resourceComputeRegionInstanceGroupManager(),
-				"google_compute_route":                         resourceComputeRoute(),
-				"google_compute_router":                        resourceComputeRouter(),
-				"google_compute_router_interface":              resourceComputeRouterInterface(),
-				"google_compute_router_nat":                    resourceComputeRouterNat(),
-				"google_compute_router_peer":                   resourceComputeRouterPeer(),
-				"google_compute_security_policy":               resourceComputeSecurityPolicy(),
-				"google_compute_shared_vpc_host_project":       resourceComputeSharedVpcHostProject(),
-				"google_compute_shared_vpc_service_project":    resourceComputeSharedVpcServiceProject(),
-				"google_compute_ssl_certificate":               resourceComputeSslCertificate(),
-				"google_compute_ssl_policy":                    resourceComputeSslPolicy(),
-				"google_compute_subnetwork":                    resourceComputeSubnetwork(),
-				"google_compute_subnetwork_iam_binding":        ResourceIamBindingWithImport(IamComputeSubnetworkSchema, NewComputeSubnetworkIamUpdater, ComputeSubnetworkIdParseFunc),
-				"google_compute_subnetwork_iam_member":         ResourceIamMemberWithImport(IamComputeSubnetworkSchema, NewComputeSubnetworkIamUpdater, ComputeSubnetworkIdParseFunc),
-				"google_compute_subnetwork_iam_policy":         ResourceIamPolicyWithImport(IamComputeSubnetworkSchema, NewComputeSubnetworkIamUpdater, ComputeSubnetworkIdParseFunc),
-				"google_compute_target_https_proxy":            resourceComputeTargetHttpsProxy(),
-				"google_compute_target_tcp_proxy":              resourceComputeTargetTcpProxy(),
-				"google_compute_target_pool":                   resourceComputeTargetPool(),
-				"google_compute_url_map":                       resourceComputeUrlMap(),
-				"google_compute_vpn_gateway":                   resourceComputeVpnGateway(),
-				"google_compute_vpn_tunnel":                    resourceComputeVpnTunnel(),
-				"google_container_cluster":                     resourceContainerCluster(),
-				"google_container_node_pool":                   resourceContainerNodePool(),
-				"google_dataflow_job":                          resourceDataflowJob(),
-				"google_dataproc_cluster":                      resourceDataprocCluster(),
-				"google_dataproc_job":                          resourceDataprocJob(),
-				"google_dns_record_set":                        resourceDnsRecordSet(),
-				"google_endpoints_service":                     resourceEndpointsService(),
-				"google_folder":                                resourceGoogleFolder(),
-				"google_folder_iam_binding":                    ResourceIamBindingWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
-				"google_folder_iam_member":                     ResourceIamMemberWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
-				"google_folder_iam_policy":                     ResourceIamPolicyWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
-				"google_folder_organization_policy":            resourceGoogleFolderOrganizationPolicy(),
-				"google_logging_billing_account_sink":          resourceLoggingBillingAccountSink(),
-				"google_logging_billing_account_exclusion":     ResourceLoggingExclusion(BillingAccountLoggingExclusionSchema, NewBillingAccountLoggingExclusionUpdater, billingAccountLoggingExclusionIdParseFunc),
-				"google_logging_organization_sink":             resourceLoggingOrganizationSink(),
-				"google_logging_organization_exclusion":        ResourceLoggingExclusion(OrganizationLoggingExclusionSchema, NewOrganizationLoggingExclusionUpdater, organizationLoggingExclusionIdParseFunc),
-				"google_logging_folder_sink":                   resourceLoggingFolderSink(),
-				"google_logging_folder_exclusion":              ResourceLoggingExclusion(FolderLoggingExclusionSchema, NewFolderLoggingExclusionUpdater, folderLoggingExclusionIdParseFunc),
-				"google_logging_project_sink":                  resourceLoggingProjectSink(),
-				"google_logging_project_exclusion":             ResourceLoggingExclusion(ProjectLoggingExclusionSchema, NewProjectLoggingExclusionUpdater, projectLoggingExclusionIdParseFunc),
-				"google_kms_key_ring":                          resourceKmsKeyRing(),
-				"google_kms_key_ring_iam_binding":              ResourceIamBindingWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
-				"google_kms_key_ring_iam_member":               ResourceIamMemberWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
-				"google_kms_key_ring_iam_policy":               ResourceIamPolicyWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
-				"google_kms_crypto_key":                        resourceKmsCryptoKey(),
-				"google_kms_crypto_key_iam_binding":            ResourceIamBindingWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc),
-				"google_kms_crypto_key_iam_member":             ResourceIamMemberWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc),
-				"google_service_networking_connection":         resourceServiceNetworkingConnection(),
-				"google_sourcerepo_repository":                 resourceSourceRepoRepository(),
-				"google_spanner_instance":                      resourceSpannerInstance(),
-				"google_spanner_instance_iam_binding":          ResourceIamBindingWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
-				"google_spanner_instance_iam_member":           ResourceIamMemberWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
-				"google_spanner_instance_iam_policy":           ResourceIamPolicyWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
-				"google_spanner_database":                      resourceSpannerDatabase(),
-				"google_spanner_database_iam_binding":          ResourceIamBindingWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
-				"google_spanner_database_iam_member":           ResourceIamMemberWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
-				"google_spanner_database_iam_policy":           ResourceIamPolicyWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
-				"google_sql_database":                          resourceSqlDatabase(),
-				"google_sql_database_instance":                 resourceSqlDatabaseInstance(),
-				"google_sql_ssl_cert":                          resourceSqlSslCert(),
-				"google_sql_user":                              resourceSqlUser(),
-				"google_organization_iam_binding":              ResourceIamBindingWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
-				"google_organization_iam_custom_role":          resourceGoogleOrganizationIamCustomRole(),
-				"google_organization_iam_member":               ResourceIamMemberWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
-				"google_organization_iam_policy":               ResourceIamPolicyWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
-				"google_organization_policy":                   resourceGoogleOrganizationPolicy(),
-				"google_project":                               resourceGoogleProject(),
-				"google_project_iam_policy":                    resourceGoogleProjectIamPolicy(),
-				"google_project_iam_binding":                   ResourceIamBindingWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
-				"google_project_iam_member":                    ResourceIamMemberWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
-				"google_project_service":                       resourceGoogleProjectService(),
-				"google_project_iam_custom_role":               resourceGoogleProjectIamCustomRole(),
-				"google_project_organization_policy":           resourceGoogleProjectOrganizationPolicy(),
-				"google_project_usage_export_bucket":           resourceProjectUsageBucket(),
-				"google_project_services":                      resourceGoogleProjectServices(),
-				"google_pubsub_topic":                          resourcePubsubTopic(),
-				"google_pubsub_topic_iam_binding":              ResourceIamBindingWithImport(IamPubsubTopicSchema, NewPubsubTopicIamUpdater, PubsubTopicIdParseFunc),
-				"google_pubsub_topic_iam_member":               ResourceIamMemberWithImport(IamPubsubTopicSchema, NewPubsubTopicIamUpdater, PubsubTopicIdParseFunc),
-				"google_pubsub_topic_iam_policy":               ResourceIamPolicyWithImport(IamPubsubTopicSchema, NewPubsubTopicIamUpdater, PubsubTopicIdParseFunc),
-				"google_pubsub_subscription":                   resourcePubsubSubscription(),
-				"google_pubsub_subscription_iam_binding":       ResourceIamBindingWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
-				"google_pubsub_subscription_iam_member":        ResourceIamMemberWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
-				"google_pubsub_subscription_iam_policy":        ResourceIamPolicyWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
-				"google_runtimeconfig_config":                  resourceRuntimeconfigConfig(),
-				"google_runtimeconfig_variable":                resourceRuntimeconfigVariable(),
-				"google_service_account":                       resourceGoogleServiceAccount(),
-				"google_service_account_iam_binding":           ResourceIamBindingWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
-				"google_service_account_iam_member":            ResourceIamMemberWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
-				"google_service_account_iam_policy":            ResourceIamPolicyWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
-				"google_service_account_key":                   resourceGoogleServiceAccountKey(),
-				"google_storage_bucket":                        resourceStorageBucket(),
-				"google_storage_bucket_acl":                    resourceStorageBucketAcl(),
+				"google_app_engine_application":                  resourceAppEngineApplication(),
+				"google_bigquery_dataset":                        resourceBigQueryDataset(),
+				"google_bigquery_table":                          resourceBigQueryTable(),
+				"google_bigtable_instance":                       resourceBigtableInstance(),
+				"google_bigtable_table":                          resourceBigtableTable(),
+				"google_billing_budget":                          resourceBillingBudget(),
+				"google_billing_account_iam_binding":             ResourceIamBindingWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
+				"google_billing_account_iam_member":              ResourceIamMemberWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
+				"google_billing_account_iam_policy":              ResourceIamPolicyWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
+				"google_cloudbuild_trigger":                      resourceCloudBuildTrigger(),
+				"google_cloudfunctions_function":                 resourceCloudFunctionsFunction(),
+				"google_cloudiot_registry":                       resourceCloudIoTRegistry(),
+				"google_composer_environment":                    resourceComposerEnvironment(),
+				"google_compute_autoscaler":                      resourceComputeAutoscaler(),
+				"google_compute_address":                         resourceComputeAddress(),
+				"google_compute_attached_disk":                   resourceComputeAttachedDisk(),
+				"google_compute_backend_bucket_signed_url_key":   resourceComputeBackendBucketSignedUrlKey(),
+				"google_compute_backend_service":                 resourceComputeBackendService(),
+				"google_compute_backend_service_signed_url_key":  resourceComputeBackendServiceSignedUrlKey(),
+				"google_compute_disk":                            resourceComputeDisk(),
+				"google_compute_disk_resource_policy_attachment": resourceComputeDiskResourcePolicyAttachment(),
+				"google_compute_snapshot":                        resourceComputeSnapshot(),
+				"google_compute_external_vpn_gateway":            resourceComputeExternalVpnGateway(),
+				"google_compute_firewall":                        resourceComputeFirewall(),
+				"google_compute_forwarding_rule":                 resourceComputeForwardingRule(),
+				"google_compute_global_forwarding_rule":          resourceComputeGlobalForwardingRule(),
+				"google_compute_health_check":                    resourceComputeHealthCheck(),
+				"google_compute_ha_vpn_gateway":                  resourceComputeHaVpnGateway(),
+				"google_compute_image":                           resourceComputeImage(),
+				"google_compute_instance":                        resourceComputeInstance(),
+				"google_compute_instance_from_template":          resourceComputeInstanceFromTemplate(),
+				"google_compute_instance_group":                  resourceComputeInstanceGroup(),
+				"google_compute_instance_group_manager":          resourceComputeInstanceGroupManager(),
+				"google_compute_instance_template":               resourceComputeInstanceTemplate(),
+				"google_compute_managed_ssl_certificate":         resourceComputeManagedSslCertificate(),
+				"google_compute_network":                         resourceComputeNetwork(),
+				"google_compute_network_endpoint":                resourceComputeNetworkEndpoint(),
+				"google_compute_network_endpoint_group":          resourceComputeNetworkEndpointGroup(),
+				"google_compute_network_peering":                 resourceComputeNetworkPeering(),
+				"google_compute_node_group":                      resourceComputeNodeGroup(),
+				"google_compute_node_template":                   resourceComputeNodeTemplate(),
+				"google_compute_per_instance_config":             resourceComputePerInstanceConfig(),
+				"google_compute_project_metadata":                resourceComputeProjectMetadata(),
+				"google_compute_project_metadata_item":           resourceComputeProjectMetadataItem(),
+				"google_compute_region_autoscaler":               resourceComputeRegionAutoscaler(),
+				"google_compute_region_backend_service":          resourceComputeRegionBackendService(),
+				"google_compute_region_instance_group_manager":   resourceComputeRegionInstanceGroupManager(),
+				"google_compute_resource_policy":                 resourceComputeResourcePolicy(),
+				"google_compute_route":                           resourceComputeRoute(),
+				"google_compute_router":                          resourceComputeRouter(),
+				"google_compute_router_interface":                resourceComputeRouterInterface(),
+				"google_compute_router_nat":                      resourceComputeRouterNat(),
+				"google_compute_router_peer":                     resourceComputeRouterPeer(),
+				"google_compute_security_policy":                 resourceComputeSecurityPolicy(),
+				"google_compute_shared_vpc_host_project":         resourceComputeSharedVpcHostProject(),
+				"google_compute_shared_vpc_service_project":      resourceComputeSharedVpcServiceProject(),
+				"google_compute_ssl_certificate":                 resourceComputeSslCertificate(),
+				"google_compute_ssl_policy":                      resourceComputeSslPolicy(),
+				"google_compute_subnetwork":                      resourceComputeSubnetwork(),
+				"google_compute_subnetwork_iam_binding":          ResourceIamBindingWithImport(IamComputeSubnetworkSchema, NewComputeSubnetworkIamUpdater, ComputeSubnetworkIdParseFunc),
+				"google_compute_subnetwork_iam_member":           ResourceIamMemberWithImport(IamComputeSubnetworkSchema, NewComputeSubnetworkIamUpdater, ComputeSubnetworkIdParseFunc),
+				"google_compute_subnetwork_iam_policy":           ResourceIamPolicyWithImport(IamComputeSubnetworkSchema, NewComputeSubnetworkIamUpdater, ComputeSubnetworkIdParseFunc),
+				"google_compute_target_https_proxy":              resourceComputeTargetHttpsProxy(),
+				"google_compute_target_tcp_proxy":                resourceComputeTargetTcpProxy(),
+				"google_compute_target_pool":                     resourceComputeTargetPool(),
+				"google_compute_url_map":                         resourceComputeUrlMap(),
+				"google_compute_vpn_gateway":                     resourceComputeVpnGateway(),
+				"google_compute_vpn_tunnel":                      resourceComputeVpnTunnel(),
+				"google_container_cluster":                       resourceContainerCluster(),
+				"google_container_node_pool":                     resourceContainerNodePool(),
+				"google_dataflow_job":                            resourceDataflowJob(),
+				"google_dataproc_cluster":                        resourceDataprocCluster(),
+				"google_dataproc_job":                            resourceDataprocJob(),
+				"google_dns_record_set":                          resourceDnsRecordSet(),
+				"google_endpoints_service":                       resourceEndpointsService(),
+				"google_folder":                                  resourceGoogleFolder(),
+				"google_folder_iam_binding":                      ResourceIamBindingWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
+				"google_folder_iam_member":                       ResourceIamMemberWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
+				"google_folder_iam_policy":                       ResourceIamPolicyWithSelfLockoutProtection(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc, "resourcemanager.folders.setIamPolicy"),
+				"google_folder_organization_policy":              resourceGoogleFolderOrganizationPolicy(),
+				"google_logging_billing_account_sink":            resourceLoggingBillingAccountSink(),
+				"google_logging_billing_account_exclusion":       ResourceLoggingExclusion(BillingAccountLoggingExclusionSchema, NewBillingAccountLoggingExclusionUpdater, billingAccountLoggingExclusionIdParseFunc),
+				"google_logging_organization_sink":               resourceLoggingOrganizationSink(),
+				"google_logging_organization_exclusion":          ResourceLoggingExclusion(OrganizationLoggingExclusionSchema, NewOrganizationLoggingExclusionUpdater, organizationLoggingExclusionIdParseFunc),
+				"google_logging_folder_sink":                     resourceLoggingFolderSink(),
+				"google_logging_folder_exclusion":                ResourceLoggingExclusion(FolderLoggingExclusionSchema, NewFolderLoggingExclusionUpdater, folderLoggingExclusionIdParseFunc),
+				"google_logging_project_sink":                    resourceLoggingProjectSink(),
+				"google_logging_project_exclusion":               ResourceLoggingExclusion(ProjectLoggingExclusionSchema, NewProjectLoggingExclusionUpdater, projectLoggingExclusionIdParseFunc),
+				"google_kms_key_ring":                            resourceKmsKeyRing(),
+				"google_kms_key_ring_iam_binding":                ResourceIamBindingWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
+				"google_kms_key_ring_iam_member":                 ResourceIamMemberWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
+				"google_kms_key_ring_iam_policy":                 ResourceIamPolicyWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
+				"google_kms_crypto_key":                          resourceKmsCryptoKey(),
+				"google_kms_crypto_key_iam_binding":              ResourceIamBindingWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc),
+				"google_kms_crypto_key_iam_member":               ResourceIamMemberWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc),
+				"google_kms_crypto_key_iam_policy":               ResourceIamPolicyWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc),
+				"google_service_networking_connection":           resourceServiceNetworkingConnection(),
+				"google_sourcerepo_repository":                   resourceSourceRepoRepository(),
+				"google_spanner_instance":                        resourceSpannerInstance(),
+				"google_spanner_instance_iam_binding":            ResourceIamBindingWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
+				"google_spanner_instance_iam_member":             ResourceIamMemberWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
+				"google_spanner_instance_iam_policy":             ResourceIamPolicyWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
+				"google_spanner_database":                        resourceSpannerDatabase(),
+				"google_spanner_database_iam_binding":            ResourceIamBindingWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
+				"google_spanner_database_iam_member":             ResourceIamMemberWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
+				"google_spanner_database_iam_policy":             ResourceIamPolicyWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
+				"google_sql_database":                            resourceSqlDatabase(),
+				"google_sql_database_instance":                   resourceSqlDatabaseInstance(),
+				"google_sql_ssl_cert":                            resourceSqlSslCert(),
+				"google_sql_user":                                resourceSqlUser(),
+				"google_organization_iam_binding":                ResourceIamBindingWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
+				"google_organization_iam_custom_role":            resourceGoogleOrganizationIamCustomRole(),
+				"google_organization_iam_member":                 ResourceIamMemberWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
+				"google_organization_iam_policy":                 ResourceIamPolicyWithSelfLockoutProtection(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc, "resourcemanager.organizations.setIamPolicy"),
+				"google_organization_policy":                     resourceGoogleOrganizationPolicy(),
+				"google_project":                                 resourceGoogleProject(),
+				"google_project_iam_policy":                      resourceGoogleProjectIamPolicy(),
+				"google_project_iam_binding":                     ResourceIamBindingWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
+				"google_project_iam_member":                      ResourceIamMemberWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
+				"google_project_service":                         resourceGoogleProjectService(),
+				"google_project_iam_custom_role":                 resourceGoogleProjectIamCustomRole(),
+				"google_project_organization_policy":             resourceGoogleProjectOrganizationPolicy(),
+				"google_project_usage_export_bucket":             resourceProjectUsageBucket(),
+				"google_project_services":                        resourceGoogleProjectServices(),
+				"google_pubsub_topic":                            resourcePubsubTopic(),
+				"google_pubsub_topic_iam_binding":                ResourceIamBindingWithImport(IamPubsubTopicSchema, NewPubsubTopicIamUpdater, PubsubTopicIdParseFunc),
+				"google_pubsub_topic_iam_member":                 ResourceIamMemberWithImport(IamPubsubTopicSchema, NewPubsubTopicIamUpdater, PubsubTopicIdParseFunc),
+				"google_pubsub_topic_iam_policy":                 ResourceIamPolicyWithImport(IamPubsubTopicSchema, NewPubsubTopicIamUpdater, PubsubTopicIdParseFunc),
+				"google_pubsub_subscription":                     resourcePubsubSubscription(),
+				"google_pubsub_subscription_iam_binding":         ResourceIamBindingWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
+				"google_pubsub_subscription_iam_member":          ResourceIamMemberWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
+				"google_pubsub_subscription_iam_policy":          ResourceIamPolicyWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
+				"google_runtimeconfig_config":                    resourceRuntimeconfigConfig(),
+				"google_runtimeconfig_variable":                  resourceRuntimeconfigVariable(),
+				"google_service_account":                         resourceGoogleServiceAccount(),
+				"google_service_account_iam_binding":             ResourceIamBindingWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
+				"google_service_account_iam_member":              ResourceIamMemberWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
+				"google_service_account_iam_policy":              ResourceIamPolicyWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
+				"google_service_account_key":                     resourceGoogleServiceAccountKey(),
+				"google_storage_bucket":                          resourceStorageBucket(),
+				"google_storage_bucket_acl":                      resourceStorageBucketAcl(),
 				// Legacy roles such as roles/storage.legacyBucketReader are automatically added
 				// when creating a bucket. For this reason, it is better not to add the authoritative
 				// google_storage_bucket_iam_policy resource.
-				"google_storage_bucket_iam_binding": ResourceIamBinding(IamStorageBucketSchema, NewStorageBucketIamUpdater),
-				"google_storage_bucket_iam_member":  ResourceIamMember(IamStorageBucketSchema, NewStorageBucketIamUpdater),
-				"google_storage_bucket_iam_policy":  ResourceIamPolicy(IamStorageBucketSchema, NewStorageBucketIamUpdater),
+				"google_storage_bucket_iam_binding": ResourceIamBindingWithImport(IamStorageBucketSchema, NewStorageBucketIamUpdater, StorageBucketIdParseFunc),
+				"google_storage_bucket_iam_member":  ResourceIamMemberWithImport(IamStorageBucketSchema, NewStorageBucketIamUpdater, StorageBucketIdParseFunc),
+				"google_storage_bucket_iam_policy":  ResourceIamPolicyWithImport(IamStorageBucketSchema, NewStorageBucketIamUpdater, StorageBucketIdParseFunc),
 				"google_storage_bucket_object":      resourceStorageBucketObject(),
 				"google_storage_object_acl":         resourceStorageObjectAcl(),
 				"google_storage_default_object_acl": resourceStorageDefaultObjectAcl(),
@@ -267,6 +321,14 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Zone:        d.Get("zone").(string),
 	}
 
+	config.QuotaCheck = d.Get("quota_check").(string)
+	if config.QuotaCheck == "" && d.Get("quota_warnings").(bool) {
+		config.QuotaCheck = computeQuotaCheckWarn
+	}
+	if config.QuotaCheck != "" {
+		config.computeQuotas = newComputeQuotaTracker()
+	}
+
 	if err := config.loadAndValidate(); err != nil {
 		return nil, err
 	}
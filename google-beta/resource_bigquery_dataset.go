@@ -19,8 +19,9 @@ func resourceBigQueryDataset() *schema.Resource {
 		Update: resourceBigQueryDatasetUpdate,
 		Delete: resourceBigQueryDatasetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceBigQueryDatasetImport,
 		},
+		CustomizeDiff: deletionProtectionCustomizeDiff("google_bigquery_dataset", resourceBigQueryDataset),
 		Schema: map[string]*schema.Schema{
 			// DatasetId: [Required] A unique ID for this dataset, without the
 			// project name. The ID must contain only letters (a-z, A-Z), numbers
@@ -53,6 +54,14 @@ func resourceBigQueryDataset() *schema.Resource {
 				ForceNew: true,
 			},
 
+			// DeletionProtection: [Optional] Whether Terraform is prevented from
+			// deleting the dataset. Only stored in state.
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			// FriendlyName: [Optional] A descriptive name for the dataset.
 			"friendly_name": {
 				Type:     schema.TypeString,
@@ -378,6 +387,10 @@ func resourceBigQueryDatasetRead(d *schema.ResourceData, meta interface{}) error
 func resourceBigQueryDatasetUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if onlyDeletionProtectionChanged(d, resourceBigQueryDataset().Schema) {
+		return resourceBigQueryDatasetRead(d, meta)
+	}
+
 	dataset, err := resourceDataset(d, meta)
 	if err != nil {
 		return err
@@ -400,6 +413,10 @@ func resourceBigQueryDatasetUpdate(d *schema.ResourceData, meta interface{}) err
 func resourceBigQueryDatasetDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if err := checkDeletionProtection(d, "google_bigquery_dataset"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Deleting BigQuery dataset: %s", d.Id())
 
 	id, err := parseBigQueryDatasetId(d.Id())
@@ -415,6 +432,12 @@ func resourceBigQueryDatasetDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func resourceBigQueryDatasetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// deletion_protection is only stored in state
+	d.Set("deletion_protection", false)
+	return []*schema.ResourceData{d}, nil
+}
+
 type bigQueryDatasetId struct {
 	Project, DatasetId string
 }
@@ -1,14 +1,24 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+
+	"google.golang.org/api/bigquery/v2"
 )
 
+func init() {
+	addTestSweeper("gcp_bigquery_dataset", testSweeper{
+		List:   testSweepBigQueryDatasetList,
+		Delete: testSweepBigQueryDatasetDelete,
+	})
+}
+
 func TestAccBigQueryDataset_basic(t *testing.T) {
 	t.Parallel()
 
@@ -219,3 +229,19 @@ resource "google_bigquery_dataset" "access_test" {
   }
 }`, otherDatasetID, otherTableID, datasetID)
 }
+
+func testSweepBigQueryDatasetList(config *Config, region string) ([]string, error) {
+	names := make([]string, 0)
+	err := config.clientBigQuery.Datasets.List(config.Project).All(true).Pages(context.Background(), func(res *bigquery.DatasetList) error {
+		for _, dataset := range res.Datasets {
+			names = append(names, dataset.DatasetReference.DatasetId)
+		}
+		return nil
+	})
+	return names, err
+}
+
+// Deleting the dataset's contents as well sweeps the tables and views in it.
+func testSweepBigQueryDatasetDelete(config *Config, region, datasetId string) error {
+	return config.clientBigQuery.Datasets.Delete(config.Project, datasetId).DeleteContents(true).Do()
+}
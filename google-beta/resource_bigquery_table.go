@@ -19,8 +19,9 @@ func resourceBigQueryTable() *schema.Resource {
 		Delete: resourceBigQueryTableDelete,
 		Update: resourceBigQueryTableUpdate,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceBigQueryTableImport,
 		},
+		CustomizeDiff: deletionProtectionCustomizeDiff("google_bigquery_table", resourceBigQueryTable),
 		Schema: map[string]*schema.Schema{
 			// TableId: [Required] The ID of the table. The ID must contain only
 			// letters (a-z, A-Z), numbers (0-9), or underscores (_). The maximum
@@ -46,6 +47,14 @@ func resourceBigQueryTable() *schema.Resource {
 				ForceNew: true,
 			},
 
+			// DeletionProtection: [Optional] Whether Terraform is prevented from
+			// deleting the table. Only stored in state.
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			// Description: [Optional] A user-friendly description of this table.
 			"description": {
 				Type:     schema.TypeString,
@@ -367,6 +376,10 @@ func resourceBigQueryTableRead(d *schema.ResourceData, meta interface{}) error {
 func resourceBigQueryTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if onlyDeletionProtectionChanged(d, resourceBigQueryTable().Schema) {
+		return resourceBigQueryTableRead(d, meta)
+	}
+
 	table, err := resourceTable(d, meta)
 	if err != nil {
 		return err
@@ -389,6 +402,10 @@ func resourceBigQueryTableUpdate(d *schema.ResourceData, meta interface{}) error
 func resourceBigQueryTableDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if err := checkDeletionProtection(d, "google_bigquery_table"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Deleting BigQuery table: %s", d.Id())
 
 	id, err := parseBigQueryTableId(d.Id())
@@ -405,6 +422,12 @@ func resourceBigQueryTableDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func resourceBigQueryTableImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// deletion_protection is only stored in state
+	d.Set("deletion_protection", false)
+	return []*schema.ResourceData{d}, nil
+}
+
 func expandSchema(raw interface{}) (*bigquery.TableSchema, error) {
 	var fields []*bigquery.TableFieldSchema
 
@@ -15,8 +15,11 @@ func resourceBigtableInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigtableInstanceCreate,
 		Read:   resourceBigtableInstanceRead,
+		Update: resourceBigtableInstanceUpdate,
 		Delete: resourceBigtableInstanceDestroy,
 
+		CustomizeDiff: deletionProtectionCustomizeDiff("google_bigtable_instance", resourceBigtableInstance),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -76,6 +79,12 @@ func resourceBigtableInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"cluster_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -206,10 +215,19 @@ func resourceBigtableInstanceRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// Only deletion_protection can be updated, and it's only stored in state.
+func resourceBigtableInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceBigtableInstanceRead(d, meta)
+}
+
 func resourceBigtableInstanceDestroy(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	ctx := context.Background()
 
+	if err := checkDeletionProtection(d, "google_bigtable_instance"); err != nil {
+		return err
+	}
+
 	project, err := getProject(d, config)
 	if err != nil {
 		return err
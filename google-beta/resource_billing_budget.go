@@ -0,0 +1,452 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/billingbudgets/v1beta1"
+)
+
+func resourceBillingBudget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBillingBudgetCreate,
+		Read:   resourceBillingBudgetRead,
+		Update: resourceBillingBudgetUpdate,
+		Delete: resourceBillingBudgetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceBillingBudgetImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"billing_account": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"amount": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"specified_amount": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"currency_code": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"units": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"nanos": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"last_period_amount": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"threshold_rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"threshold_percent": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"spend_basis": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "CURRENT_SPEND",
+							ValidateFunc: validation.StringInSlice([]string{"CURRENT_SPEND", "FORECASTED_SPEND"}, false),
+						},
+					},
+				},
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"budget_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"projects": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"services": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"credit_types_treatment": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "INCLUDE_ALL_CREDITS",
+							ValidateFunc: validation.StringInSlice([]string{"INCLUDE_ALL_CREDITS", "EXCLUDE_ALL_CREDITS"}, false),
+						},
+					},
+				},
+			},
+			"all_updates_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pubsub_topic": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"schema_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "1.0",
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBillingBudgetCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	budget, err := expandBillingBudget(d)
+	if err != nil {
+		return err
+	}
+
+	parent := "billingAccounts/" + d.Get("billing_account").(string)
+
+	log.Printf("[DEBUG] Creating new Budget: %#v", budget)
+	res, err := config.clientBillingBudgets.BillingAccounts.Budgets.Create(parent, &billingbudgets.GoogleCloudBillingBudgetsV1beta1CreateBudgetRequest{
+		Budget: budget,
+	}).Do()
+	if err != nil {
+		return fmt.Errorf("Error creating Budget: %s", err)
+	}
+
+	// The budget's name is generated by the API, and includes the billing
+	// account: billingAccounts/{billing_account}/budgets/{budget}.
+	d.SetId(res.Name)
+
+	log.Printf("[DEBUG] Finished creating Budget %q: %#v", d.Id(), res)
+
+	return resourceBillingBudgetRead(d, meta)
+}
+
+func resourceBillingBudgetRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	res, err := config.clientBillingBudgets.BillingAccounts.Budgets.Get(d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("BillingBudget %q", d.Id()))
+	}
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 {
+		return fmt.Errorf("Invalid budget name %q, expected billingAccounts/{billing_account}/budgets/{budget}", d.Id())
+	}
+
+	if err := d.Set("billing_account", parts[1]); err != nil {
+		return fmt.Errorf("Error reading Budget: %s", err)
+	}
+	if err := d.Set("name", parts[3]); err != nil {
+		return fmt.Errorf("Error reading Budget: %s", err)
+	}
+	if err := d.Set("display_name", res.DisplayName); err != nil {
+		return fmt.Errorf("Error reading Budget: %s", err)
+	}
+	if err := d.Set("budget_filter", flattenBillingBudgetBudgetFilter(res.BudgetFilter)); err != nil {
+		return fmt.Errorf("Error reading Budget: %s", err)
+	}
+	if err := d.Set("amount", flattenBillingBudgetAmount(res.Amount)); err != nil {
+		return fmt.Errorf("Error reading Budget: %s", err)
+	}
+	if err := d.Set("threshold_rules", flattenBillingBudgetThresholdRules(res.ThresholdRules)); err != nil {
+		return fmt.Errorf("Error reading Budget: %s", err)
+	}
+	if err := d.Set("all_updates_rule", flattenBillingBudgetAllUpdatesRule(res.AllUpdatesRule)); err != nil {
+		return fmt.Errorf("Error reading Budget: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBillingBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	budget, err := expandBillingBudget(d)
+	if err != nil {
+		return err
+	}
+
+	// Without an update mask only fields with non-default values are updated,
+	// so fields that were removed from config wouldn't be cleared.
+	updateMask := []string{}
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+	if d.HasChange("budget_filter") {
+		updateMask = append(updateMask, "budgetFilter")
+	}
+	if d.HasChange("amount") {
+		updateMask = append(updateMask, "amount")
+	}
+	if d.HasChange("threshold_rules") {
+		updateMask = append(updateMask, "thresholdRules")
+	}
+	if d.HasChange("all_updates_rule") {
+		updateMask = append(updateMask, "allUpdatesRule")
+	}
+	if len(updateMask) == 0 {
+		return resourceBillingBudgetRead(d, meta)
+	}
+
+	log.Printf("[DEBUG] Updating Budget %q: %#v", d.Id(), budget)
+	_, err = config.clientBillingBudgets.BillingAccounts.Budgets.Patch(d.Id(), &billingbudgets.GoogleCloudBillingBudgetsV1beta1UpdateBudgetRequest{
+		Budget:     budget,
+		UpdateMask: strings.Join(updateMask, ","),
+	}).Do()
+	if err != nil {
+		return fmt.Errorf("Error updating Budget %q: %s", d.Id(), err)
+	}
+
+	return resourceBillingBudgetRead(d, meta)
+}
+
+func resourceBillingBudgetDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting Budget %q", d.Id())
+	_, err := config.clientBillingBudgets.BillingAccounts.Budgets.Delete(d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, "Budget")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Budget %q", d.Id())
+	return nil
+}
+
+func resourceBillingBudgetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"billingAccounts/(?P<billing_account>[^/]+)/budgets/(?P<name>[^/]+)", "(?P<billing_account>[^/]+)/(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "billingAccounts/{{billing_account}}/budgets/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandBillingBudget(d *schema.ResourceData) (*billingbudgets.GoogleCloudBillingBudgetsV1beta1Budget, error) {
+	amount, err := expandBillingBudgetAmount(d.Get("amount").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &billingbudgets.GoogleCloudBillingBudgetsV1beta1Budget{
+		DisplayName:    d.Get("display_name").(string),
+		BudgetFilter:   expandBillingBudgetBudgetFilter(d.Get("budget_filter").([]interface{})),
+		Amount:         amount,
+		ThresholdRules: expandBillingBudgetThresholdRules(d.Get("threshold_rules").([]interface{})),
+		AllUpdatesRule: expandBillingBudgetAllUpdatesRule(d.Get("all_updates_rule").([]interface{})),
+	}, nil
+}
+
+func expandBillingBudgetBudgetFilter(v []interface{}) *billingbudgets.GoogleCloudBillingBudgetsV1beta1Filter {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	raw := v[0].(map[string]interface{})
+
+	return &billingbudgets.GoogleCloudBillingBudgetsV1beta1Filter{
+		CreditTypesTreatment: raw["credit_types_treatment"].(string),
+		Projects:             convertStringSet(raw["projects"].(*schema.Set)),
+		Services:             convertStringSet(raw["services"].(*schema.Set)),
+	}
+}
+
+func expandBillingBudgetAmount(v []interface{}) (*billingbudgets.GoogleCloudBillingBudgetsV1beta1BudgetAmount, error) {
+	if len(v) == 0 || v[0] == nil {
+		return nil, fmt.Errorf("amount must set one of specified_amount or last_period_amount")
+	}
+	raw := v[0].(map[string]interface{})
+
+	specified := raw["specified_amount"].([]interface{})
+	lastPeriod := raw["last_period_amount"].(bool)
+	if len(specified) > 0 && lastPeriod {
+		return nil, fmt.Errorf("amount can only set one of specified_amount or last_period_amount")
+	}
+
+	if lastPeriod {
+		return &billingbudgets.GoogleCloudBillingBudgetsV1beta1BudgetAmount{
+			LastPeriodAmount: &billingbudgets.GoogleCloudBillingBudgetsV1beta1LastPeriodAmount{},
+		}, nil
+	}
+	if len(specified) == 0 {
+		return nil, fmt.Errorf("amount must set one of specified_amount or last_period_amount")
+	}
+
+	money := &billingbudgets.GoogleTypeMoney{}
+	if specified[0] != nil {
+		m := specified[0].(map[string]interface{})
+		money.CurrencyCode = m["currency_code"].(string)
+		if u := m["units"].(string); u != "" {
+			units, err := strconv.ParseInt(u, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid amount units %q: %s", u, err)
+			}
+			money.Units = units
+		}
+		money.Nanos = int64(m["nanos"].(int))
+	}
+	return &billingbudgets.GoogleCloudBillingBudgetsV1beta1BudgetAmount{
+		SpecifiedAmount: money,
+	}, nil
+}
+
+func expandBillingBudgetThresholdRules(v []interface{}) []*billingbudgets.GoogleCloudBillingBudgetsV1beta1ThresholdRule {
+	transformed := make([]*billingbudgets.GoogleCloudBillingBudgetsV1beta1ThresholdRule, 0, len(v))
+	for _, raw := range v {
+		if raw == nil {
+			continue
+		}
+		rule := raw.(map[string]interface{})
+		transformed = append(transformed, &billingbudgets.GoogleCloudBillingBudgetsV1beta1ThresholdRule{
+			ThresholdPercent: rule["threshold_percent"].(float64),
+			SpendBasis:       rule["spend_basis"].(string),
+		})
+	}
+	return transformed
+}
+
+func expandBillingBudgetAllUpdatesRule(v []interface{}) *billingbudgets.GoogleCloudBillingBudgetsV1beta1AllUpdatesRule {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	raw := v[0].(map[string]interface{})
+
+	return &billingbudgets.GoogleCloudBillingBudgetsV1beta1AllUpdatesRule{
+		PubsubTopic:   raw["pubsub_topic"].(string),
+		SchemaVersion: raw["schema_version"].(string),
+	}
+}
+
+func flattenBillingBudgetBudgetFilter(filter *billingbudgets.GoogleCloudBillingBudgetsV1beta1Filter) []interface{} {
+	if filter == nil {
+		return nil
+	}
+
+	creditTypesTreatment := stringOrDefault(filter.CreditTypesTreatment, "INCLUDE_ALL_CREDITS")
+	// The API echoes a filter even when none was set; treat one that only
+	// holds defaults as unset so removing the block from config clears it.
+	if len(filter.Projects) == 0 && len(filter.Services) == 0 && creditTypesTreatment == "INCLUDE_ALL_CREDITS" {
+		return nil
+	}
+
+	transformed := map[string]interface{}{
+		"credit_types_treatment": creditTypesTreatment,
+	}
+	if len(filter.Projects) > 0 {
+		transformed["projects"] = schema.NewSet(schema.HashString, convertStringArrToInterface(filter.Projects))
+	}
+	if len(filter.Services) > 0 {
+		transformed["services"] = schema.NewSet(schema.HashString, convertStringArrToInterface(filter.Services))
+	}
+	return []interface{}{transformed}
+}
+
+func flattenBillingBudgetAmount(amount *billingbudgets.GoogleCloudBillingBudgetsV1beta1BudgetAmount) []interface{} {
+	if amount == nil {
+		return nil
+	}
+
+	transformed := map[string]interface{}{
+		"last_period_amount": amount.LastPeriodAmount != nil,
+	}
+	if money := amount.SpecifiedAmount; money != nil {
+		specified := map[string]interface{}{
+			"currency_code": money.CurrencyCode,
+			"nanos":         int(money.Nanos),
+		}
+		// units is left out of responses when it's zero.
+		if money.Units != 0 {
+			specified["units"] = strconv.FormatInt(money.Units, 10)
+		}
+		transformed["specified_amount"] = []interface{}{specified}
+	}
+	return []interface{}{transformed}
+}
+
+func flattenBillingBudgetThresholdRules(rules []*billingbudgets.GoogleCloudBillingBudgetsV1beta1ThresholdRule) []interface{} {
+	transformed := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"threshold_percent": rule.ThresholdPercent,
+			"spend_basis":       stringOrDefault(rule.SpendBasis, "CURRENT_SPEND"),
+		})
+	}
+	return transformed
+}
+
+func flattenBillingBudgetAllUpdatesRule(rule *billingbudgets.GoogleCloudBillingBudgetsV1beta1AllUpdatesRule) []interface{} {
+	if rule == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"pubsub_topic":   rule.PubsubTopic,
+			"schema_version": stringOrDefault(rule.SchemaVersion, "1.0"),
+		},
+	}
+}
+
+// Enum and string fields that have their default value may be left out of API
+// responses.
+func stringOrDefault(v string, def string) string {
+	if v != "" {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,291 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/billingbudgets/v1beta1"
+)
+
+func TestBillingBudgetAmount(t *testing.T) {
+	cases := map[string]struct {
+		Amount        []interface{}
+		Expected      *billingbudgets.GoogleCloudBillingBudgetsV1beta1BudgetAmount
+		ExpectedError bool
+	}{
+		"specified amount": {
+			Amount: []interface{}{
+				map[string]interface{}{
+					"specified_amount": []interface{}{
+						map[string]interface{}{
+							"currency_code": "USD",
+							"units":         "100",
+							"nanos":         500000000,
+						},
+					},
+					"last_period_amount": false,
+				},
+			},
+			Expected: &billingbudgets.GoogleCloudBillingBudgetsV1beta1BudgetAmount{
+				SpecifiedAmount: &billingbudgets.GoogleTypeMoney{
+					CurrencyCode: "USD",
+					Units:        100,
+					Nanos:        500000000,
+				},
+			},
+		},
+		"last period amount": {
+			Amount: []interface{}{
+				map[string]interface{}{
+					"specified_amount":   []interface{}{},
+					"last_period_amount": true,
+				},
+			},
+			Expected: &billingbudgets.GoogleCloudBillingBudgetsV1beta1BudgetAmount{
+				LastPeriodAmount: &billingbudgets.GoogleCloudBillingBudgetsV1beta1LastPeriodAmount{},
+			},
+		},
+		"both": {
+			Amount: []interface{}{
+				map[string]interface{}{
+					"specified_amount": []interface{}{
+						map[string]interface{}{
+							"currency_code": "",
+							"units":         "100",
+							"nanos":         0,
+						},
+					},
+					"last_period_amount": true,
+				},
+			},
+			ExpectedError: true,
+		},
+		"neither": {
+			Amount: []interface{}{
+				map[string]interface{}{
+					"specified_amount":   []interface{}{},
+					"last_period_amount": false,
+				},
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		amount, err := expandBillingBudgetAmount(tc.Amount)
+		if tc.ExpectedError {
+			if err == nil {
+				t.Errorf("bad: %s, expected an error", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("bad: %s, unexpected error: %s", tn, err)
+			continue
+		}
+		if !reflect.DeepEqual(amount, tc.Expected) {
+			t.Errorf("bad: %s, expected %#v, got %#v", tn, tc.Expected, amount)
+		}
+	}
+}
+
+func TestBillingBudgetBudgetFilterFlatten(t *testing.T) {
+	cases := map[string]struct {
+		Filter   *billingbudgets.GoogleCloudBillingBudgetsV1beta1Filter
+		Expected int
+	}{
+		"missing": {
+			Filter:   nil,
+			Expected: 0,
+		},
+		"defaults only": {
+			Filter: &billingbudgets.GoogleCloudBillingBudgetsV1beta1Filter{
+				CreditTypesTreatment: "INCLUDE_ALL_CREDITS",
+			},
+			Expected: 0,
+		},
+		"credit types": {
+			Filter: &billingbudgets.GoogleCloudBillingBudgetsV1beta1Filter{
+				CreditTypesTreatment: "EXCLUDE_ALL_CREDITS",
+			},
+			Expected: 1,
+		},
+		"projects": {
+			Filter: &billingbudgets.GoogleCloudBillingBudgetsV1beta1Filter{
+				Projects:             []string{"projects/123"},
+				CreditTypesTreatment: "INCLUDE_ALL_CREDITS",
+			},
+			Expected: 1,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := flattenBillingBudgetBudgetFilter(tc.Filter); len(got) != tc.Expected {
+			t.Errorf("bad: %s, expected %d filters, got %#v", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestAccBillingBudget_basic(t *testing.T) {
+	t.Parallel()
+
+	billingAccount := getTestBillingAccountFromEnv(t)
+	displayName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBillingBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBillingBudget_basic(billingAccount, displayName),
+			},
+			{
+				ResourceName:      "google_billing_budget.budget",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBillingBudget_update(t *testing.T) {
+	t.Parallel()
+
+	billingAccount := getTestBillingAccountFromEnv(t)
+	displayName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBillingBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBillingBudget_basic(billingAccount, displayName),
+			},
+			{
+				ResourceName:      "google_billing_budget.budget",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBillingBudget_update(billingAccount, displayName, topic),
+			},
+			{
+				ResourceName:      "google_billing_budget.budget",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBillingBudget_basic(billingAccount, displayName),
+				Check:  testAccCheckBillingBudgetHasNoFilter("google_billing_budget.budget"),
+			},
+			{
+				ResourceName:      "google_billing_budget.budget",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBillingBudgetHasNoFilter(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		res, err := config.clientBillingBudgets.BillingAccounts.Budgets.Get(rs.Primary.ID).Do()
+		if err != nil {
+			return err
+		}
+
+		if filter := flattenBillingBudgetBudgetFilter(res.BudgetFilter); filter != nil {
+			return fmt.Errorf("Budget %q still has a budget filter: %#v", rs.Primary.ID, res.BudgetFilter)
+		}
+		if v := rs.Primary.Attributes["budget_filter.#"]; v != "" && v != "0" {
+			return fmt.Errorf("Budget %q still has budget_filter in state", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckBillingBudgetDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_billing_budget" {
+			continue
+		}
+
+		_, err := config.clientBillingBudgets.BillingAccounts.Budgets.Get(rs.Primary.ID).Do()
+		if err == nil {
+			return fmt.Errorf("Budget %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccBillingBudget_basic(billingAccount, displayName string) string {
+	return fmt.Sprintf(`
+resource "google_billing_budget" "budget" {
+  billing_account = "%s"
+  display_name    = "%s"
+
+  amount {
+    specified_amount {
+      currency_code = "USD"
+      units         = "100"
+    }
+  }
+
+  threshold_rules {
+    threshold_percent = 0.5
+  }
+}
+`, billingAccount, displayName)
+}
+
+func testAccBillingBudget_update(billingAccount, displayName, topic string) string {
+	return fmt.Sprintf(`
+data "google_project" "project" {}
+
+resource "google_pubsub_topic" "budget" {
+  name = "%s"
+}
+
+resource "google_billing_budget" "budget" {
+  billing_account = "%s"
+  display_name    = "%s-updated"
+
+  budget_filter {
+    projects               = ["projects/${data.google_project.project.number}"]
+    credit_types_treatment = "EXCLUDE_ALL_CREDITS"
+  }
+
+  amount {
+    last_period_amount = true
+  }
+
+  threshold_rules {
+    threshold_percent = 0.9
+  }
+  threshold_rules {
+    threshold_percent = 1.0
+    spend_basis       = "FORECASTED_SPEND"
+  }
+
+  all_updates_rule {
+    pubsub_topic = "${google_pubsub_topic.budget.id}"
+  }
+}
+`, topic, billingAccount, displayName)
+}
@@ -41,6 +41,7 @@ func resourceComputeAddress() *schema.Resource {
 			Update: schema.DefaultTimeout(240 * time.Second),
 			Delete: schema.DefaultTimeout(240 * time.Second),
 		},
+		CustomizeDiff: computeQuotaCheckCustomizeDiff(computeAddressQuotaDemand),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -8,6 +8,14 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_address", testSweeper{
+		Dependencies: []string{"gcp_compute_forwarding_rule", "gcp_compute_instance"},
+		List:         listRegionalComputeResources("addresses"),
+		Delete:       sweepComputeResource,
+	})
+}
+
 func TestAccComputeAddress_basic(t *testing.T) {
 	t.Parallel()
 
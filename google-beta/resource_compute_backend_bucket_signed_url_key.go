@@ -0,0 +1,153 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	computeBeta "google.golang.org/api/compute/v0.beta"
+)
+
+func resourceComputeBackendBucketSignedUrlKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeBackendBucketSignedUrlKeyCreate,
+		Read:   resourceComputeBackendBucketSignedUrlKeyRead,
+		Delete: resourceComputeBackendBucketSignedUrlKeyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^(?:[a-z](?:[-a-z0-9]{0,61}[a-z0-9])?)$`),
+			},
+
+			"key_value": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"backend_bucket": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputeBackendBucketSignedUrlKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	backendBucket := GetResourceNameFromSelfLink(d.Get("backend_bucket").(string))
+	key := &computeBeta.SignedUrlKey{
+		KeyName:  d.Get("name").(string),
+		KeyValue: d.Get("key_value").(string),
+	}
+
+	// Keys are added to and removed from the backend bucket's CDN policy,
+	// which only accepts one change at a time.
+	lockName := signedUrlKeyLockName(project, "backendBuckets", backendBucket)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	log.Printf("[DEBUG] Adding signed URL key %q to backend bucket %q", key.KeyName, backendBucket)
+	op, err := config.clientComputeBeta.BackendBuckets.AddSignedUrlKey(project, backendBucket, key).Do()
+	if err != nil {
+		return errwrap.Wrapf("Error adding signed URL key to backend bucket: {{err}}", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", project, backendBucket, key.KeyName))
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Adding signed URL key %q", key.KeyName))
+	if err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceComputeBackendBucketSignedUrlKeyRead(d, meta)
+}
+
+func resourceComputeBackendBucketSignedUrlKeyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseSignedUrlKeyId(d.Id(), "google_compute_backend_bucket_signed_url_key")
+	if err != nil {
+		return err
+	}
+
+	backendBucket, err := config.clientComputeBeta.BackendBuckets.Get(id.Project, id.Backend).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Backend bucket %q", id.Backend))
+	}
+
+	found := false
+	if backendBucket.CdnPolicy != nil {
+		for _, name := range backendBucket.CdnPolicy.SignedUrlKeyNames {
+			if name == id.Name {
+				found = true
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[WARN] Removing BackendBucketSignedUrlKey %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("backend_bucket", id.Backend)
+	d.Set("project", id.Project)
+
+	return nil
+}
+
+func resourceComputeBackendBucketSignedUrlKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseSignedUrlKeyId(d.Id(), "google_compute_backend_bucket_signed_url_key")
+	if err != nil {
+		return err
+	}
+
+	lockName := signedUrlKeyLockName(id.Project, "backendBuckets", id.Backend)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	log.Printf("[DEBUG] Deleting signed URL key %q from backend bucket %q", id.Name, id.Backend)
+	op, err := config.clientComputeBeta.BackendBuckets.DeleteSignedUrlKey(id.Project, id.Backend, id.Name).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("BackendBucketSignedUrlKey %q", d.Id()))
+	}
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, id.Project, int(d.Timeout(schema.TimeoutDelete).Minutes()), fmt.Sprintf("Deleting signed URL key %q", id.Name))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,111 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeBackendBucketSignedUrlKey_basic(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeBackendBucketSignedUrlKeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeBackendBucketSignedUrlKey_basic(suffix),
+				Check:  testAccCheckComputeBackendBucketSignedUrlKeyExists("google_compute_backend_bucket_signed_url_key.key"),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeBackendBucketSignedUrlKeyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		id, err := parseSignedUrlKeyId(rs.Primary.ID, rs.Type)
+		if err != nil {
+			return err
+		}
+
+		backendBucket, err := config.clientComputeBeta.BackendBuckets.Get(id.Project, id.Backend).Do()
+		if err != nil {
+			return err
+		}
+
+		if backendBucket.CdnPolicy != nil {
+			for _, name := range backendBucket.CdnPolicy.SignedUrlKeyNames {
+				if name == id.Name {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Signed URL key %q not found on backend bucket %q", id.Name, id.Backend)
+	}
+}
+
+func testAccCheckComputeBackendBucketSignedUrlKeyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_backend_bucket_signed_url_key" {
+			continue
+		}
+
+		id, err := parseSignedUrlKeyId(rs.Primary.ID, rs.Type)
+		if err != nil {
+			return err
+		}
+
+		backendBucket, err := config.clientComputeBeta.BackendBuckets.Get(id.Project, id.Backend).Do()
+		if err != nil {
+			// The backend bucket is destroyed along with the key.
+			continue
+		}
+
+		if backendBucket.CdnPolicy != nil {
+			for _, name := range backendBucket.CdnPolicy.SignedUrlKeyNames {
+				if name == id.Name {
+					return fmt.Errorf("Signed URL key %q still exists on backend bucket %q", id.Name, id.Backend)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeBackendBucketSignedUrlKey_basic(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_bucket_signed_url_key" "key" {
+  name            = "test-key-%s"
+  key_value       = "iAmAFakeKeyRandomBytes=="
+  backend_bucket  = "${google_compute_backend_bucket.foobar.name}"
+}
+
+resource "google_compute_backend_bucket" "foobar" {
+  name        = "tf-test-%s"
+  bucket_name = "${google_storage_bucket.bucket.name}"
+  enable_cdn  = true
+}
+
+resource "google_storage_bucket" "bucket" {
+  name     = "tf-test-%s"
+  location = "EU"
+}
+`, suffix, suffix, suffix)
+}
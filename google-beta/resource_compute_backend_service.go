@@ -22,6 +22,8 @@ func resourceComputeBackendService() *schema.Resource {
 		},
 		SchemaVersion: 1,
 
+		CustomizeDiff: resourceComputeBackendServiceCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -105,6 +107,14 @@ func resourceComputeBackendService() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 						},
+						"max_rate_per_endpoint": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+						"max_connections_per_endpoint": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
 						"max_utilization": &schema.Schema{
 							Type:     schema.TypeFloat,
 							Optional: true,
@@ -239,6 +249,20 @@ func resourceComputeBackendService() *schema.Resource {
 	}
 }
 
+// Network endpoint groups can't be balanced on utilization, which is the
+// default balancing_mode for a backend.
+func resourceComputeBackendServiceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	for _, raw := range diff.Get("backend").(*schema.Set).List() {
+		backend := raw.(map[string]interface{})
+		group := backend["group"].(string)
+		if isNetworkEndpointGroupLink(group) && backend["balancing_mode"].(string) == "UTILIZATION" {
+			return fmt.Errorf("backend group %q is a network endpoint group and must use a RATE or CONNECTION balancing_mode", group)
+		}
+	}
+
+	return nil
+}
+
 func resourceComputeBackendServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -474,6 +498,18 @@ func expandBackends(configured []interface{}) ([]*computeBeta.Backend, error) {
 				b.NullFields = append(b.NullFields, "MaxConnectionsPerInstance")
 			}
 		}
+		if v, ok := data["max_rate_per_endpoint"]; ok {
+			b.MaxRatePerEndpoint = v.(float64)
+			if b.MaxRatePerEndpoint == 0 {
+				b.NullFields = append(b.NullFields, "MaxRatePerEndpoint")
+			}
+		}
+		if v, ok := data["max_connections_per_endpoint"]; ok {
+			b.MaxConnectionsPerEndpoint = int64(v.(int))
+			if b.MaxConnectionsPerEndpoint == 0 {
+				b.NullFields = append(b.NullFields, "MaxConnectionsPerEndpoint")
+			}
+		}
 		if v, ok := data["max_utilization"]; ok {
 			b.MaxUtilization = v.(float64)
 			b.ForceSendFields = append(b.ForceSendFields, "MaxUtilization")
@@ -499,6 +535,8 @@ func flattenBackends(backends []*computeBeta.Backend) []map[string]interface{} {
 		data["max_rate_per_instance"] = b.MaxRatePerInstance
 		data["max_connections"] = b.MaxConnections
 		data["max_connections_per_instance"] = b.MaxConnectionsPerInstance
+		data["max_rate_per_endpoint"] = b.MaxRatePerEndpoint
+		data["max_connections_per_endpoint"] = b.MaxConnectionsPerEndpoint
 		data["max_utilization"] = b.MaxUtilization
 		result = append(result, data)
 	}
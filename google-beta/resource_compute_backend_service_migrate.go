@@ -130,6 +130,12 @@ func resourceGoogleComputeBackendServiceBackendHash(v interface{}) int {
 	if v, ok := m["max_rate_per_instance"]; ok {
 		buf.WriteString(fmt.Sprintf("%f-", v.(float64)))
 	}
+	if v, ok := m["max_rate_per_endpoint"]; ok {
+		buf.WriteString(fmt.Sprintf("%f-", v.(float64)))
+	}
+	if v, ok := m["max_connections_per_endpoint"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", int64(v.(int))))
+	}
 
 	return hashcode.String(buf.String())
 }
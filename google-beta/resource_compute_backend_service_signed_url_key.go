@@ -0,0 +1,177 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	computeBeta "google.golang.org/api/compute/v0.beta"
+)
+
+func resourceComputeBackendServiceSignedUrlKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeBackendServiceSignedUrlKeyCreate,
+		Read:   resourceComputeBackendServiceSignedUrlKeyRead,
+		Delete: resourceComputeBackendServiceSignedUrlKeyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^(?:[a-z](?:[-a-z0-9]{0,61}[a-z0-9])?)$`),
+			},
+
+			"key_value": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"backend_service": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputeBackendServiceSignedUrlKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	backendService := GetResourceNameFromSelfLink(d.Get("backend_service").(string))
+	key := &computeBeta.SignedUrlKey{
+		KeyName:  d.Get("name").(string),
+		KeyValue: d.Get("key_value").(string),
+	}
+
+	// Keys are added to and removed from the backend service's CDN policy,
+	// which only accepts one change at a time.
+	lockName := signedUrlKeyLockName(project, "backendServices", backendService)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	log.Printf("[DEBUG] Adding signed URL key %q to backend service %q", key.KeyName, backendService)
+	op, err := config.clientComputeBeta.BackendServices.AddSignedUrlKey(project, backendService, key).Do()
+	if err != nil {
+		return errwrap.Wrapf("Error adding signed URL key to backend service: {{err}}", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", project, backendService, key.KeyName))
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Adding signed URL key %q", key.KeyName))
+	if err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceComputeBackendServiceSignedUrlKeyRead(d, meta)
+}
+
+func resourceComputeBackendServiceSignedUrlKeyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseSignedUrlKeyId(d.Id(), "google_compute_backend_service_signed_url_key")
+	if err != nil {
+		return err
+	}
+
+	backendService, err := config.clientComputeBeta.BackendServices.Get(id.Project, id.Backend).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Backend service %q", id.Backend))
+	}
+
+	found := false
+	if backendService.CdnPolicy != nil {
+		for _, name := range backendService.CdnPolicy.SignedUrlKeyNames {
+			if name == id.Name {
+				found = true
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[WARN] Removing BackendServiceSignedUrlKey %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("backend_service", id.Backend)
+	d.Set("project", id.Project)
+
+	return nil
+}
+
+func resourceComputeBackendServiceSignedUrlKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseSignedUrlKeyId(d.Id(), "google_compute_backend_service_signed_url_key")
+	if err != nil {
+		return err
+	}
+
+	lockName := signedUrlKeyLockName(id.Project, "backendServices", id.Backend)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	log.Printf("[DEBUG] Deleting signed URL key %q from backend service %q", id.Name, id.Backend)
+	op, err := config.clientComputeBeta.BackendServices.DeleteSignedUrlKey(id.Project, id.Backend, id.Name).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("BackendServiceSignedUrlKey %q", d.Id()))
+	}
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, id.Project, int(d.Timeout(schema.TimeoutDelete).Minutes()), fmt.Sprintf("Deleting signed URL key %q", id.Name))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func signedUrlKeyLockName(project, backendType, backend string) string {
+	return fmt.Sprintf("signedUrlKey/%s/%s/%s", project, backendType, backend)
+}
+
+type signedUrlKeyId struct {
+	Project string
+	Backend string
+	Name    string
+}
+
+func parseSignedUrlKeyId(id, resourceType string) (*signedUrlKeyId, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid %s id format, expecting `{project}/{backend}/{name}`, found %s", resourceType, id)
+	}
+
+	return &signedUrlKeyId{
+		Project: parts[0],
+		Backend: parts[1],
+		Name:    parts[2],
+	}, nil
+}
@@ -0,0 +1,113 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeBackendServiceSignedUrlKey_basic(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeBackendServiceSignedUrlKeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeBackendServiceSignedUrlKey_basic(suffix),
+				Check:  testAccCheckComputeBackendServiceSignedUrlKeyExists("google_compute_backend_service_signed_url_key.key"),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeBackendServiceSignedUrlKeyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		id, err := parseSignedUrlKeyId(rs.Primary.ID, rs.Type)
+		if err != nil {
+			return err
+		}
+
+		backendService, err := config.clientComputeBeta.BackendServices.Get(id.Project, id.Backend).Do()
+		if err != nil {
+			return err
+		}
+
+		if backendService.CdnPolicy != nil {
+			for _, name := range backendService.CdnPolicy.SignedUrlKeyNames {
+				if name == id.Name {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Signed URL key %q not found on backend service %q", id.Name, id.Backend)
+	}
+}
+
+func testAccCheckComputeBackendServiceSignedUrlKeyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_backend_service_signed_url_key" {
+			continue
+		}
+
+		id, err := parseSignedUrlKeyId(rs.Primary.ID, rs.Type)
+		if err != nil {
+			return err
+		}
+
+		backendService, err := config.clientComputeBeta.BackendServices.Get(id.Project, id.Backend).Do()
+		if err != nil {
+			// The backend service is destroyed along with the key.
+			continue
+		}
+
+		if backendService.CdnPolicy != nil {
+			for _, name := range backendService.CdnPolicy.SignedUrlKeyNames {
+				if name == id.Name {
+					return fmt.Errorf("Signed URL key %q still exists on backend service %q", id.Name, id.Backend)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeBackendServiceSignedUrlKey_basic(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_service_signed_url_key" "key" {
+  name            = "test-key-%s"
+  key_value       = "iAmAFakeKeyRandomBytes=="
+  backend_service = "${google_compute_backend_service.foobar.name}"
+}
+
+resource "google_compute_backend_service" "foobar" {
+  name          = "tf-test-%s"
+  enable_cdn    = true
+  health_checks = ["${google_compute_http_health_check.zero.self_link}"]
+}
+
+resource "google_compute_http_health_check" "zero" {
+  name               = "tf-test-%s"
+  request_path       = "/"
+  check_interval_sec = 1
+  timeout_sec        = 1
+}
+`, suffix, suffix, suffix)
+}
@@ -11,6 +11,14 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_backend_service", testSweeper{
+		Dependencies: []string{"gcp_compute_url_map"},
+		List:         listGlobalComputeResources("backendServices"),
+		Delete:       sweepComputeResource,
+	})
+}
+
 func TestAccComputeBackendService_basic(t *testing.T) {
 	t.Parallel()
 
@@ -619,6 +627,56 @@ func TestAccComputeBackendService_withCustomHeaders(t *testing.T) {
 	})
 }
 
+func TestAccComputeBackendService_withNetworkEndpointGroup(t *testing.T) {
+	t.Parallel()
+
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	negName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeBackendServiceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeBackendService_withNetworkEndpointGroup(serviceName, negName, checkName, "balancing_mode = \"RATE\"\n    max_rate_per_endpoint = 10"),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_backend_service.lipsum",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccComputeBackendService_withNetworkEndpointGroup(serviceName, negName, checkName, "balancing_mode = \"CONNECTION\"\n    max_connections_per_endpoint = 5"),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_backend_service.lipsum",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeBackendService_networkEndpointGroupUtilization(t *testing.T) {
+	t.Parallel()
+
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeBackendServiceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccComputeBackendService_networkEndpointGroupUtilization(serviceName),
+				ExpectError: regexp.MustCompile("must use a RATE or CONNECTION balancing_mode"),
+			},
+		},
+	})
+}
+
 func testAccComputeBackendService_basic(serviceName, checkName string) string {
 	return fmt.Sprintf(`
 resource "google_compute_backend_service" "foobar" {
@@ -1036,3 +1094,45 @@ resource "google_compute_http_health_check" "zero" {
 }
 `, serviceName, checkName)
 }
+
+func testAccComputeBackendService_withNetworkEndpointGroup(serviceName, negName, checkName, balancing string) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_service" "lipsum" {
+  name          = "%s"
+  health_checks = ["${google_compute_health_check.default.self_link}"]
+
+  backend {
+    group = "${google_compute_network_endpoint_group.neg.self_link}"
+    %s
+  }
+}
+
+resource "google_compute_network_endpoint_group" "neg" {
+  name         = "%s"
+  network      = "default"
+  default_port = "90"
+  zone         = "us-central1-a"
+}
+
+resource "google_compute_health_check" "default" {
+  name = "%s"
+
+  http_health_check {
+    port = "90"
+  }
+}
+`, serviceName, balancing, negName, checkName)
+}
+
+func testAccComputeBackendService_networkEndpointGroupUtilization(serviceName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_service" "lipsum" {
+  name          = "%s"
+  health_checks = ["projects/my-project/global/healthChecks/my-check"]
+
+  backend {
+    group = "projects/my-project/zones/us-central1-a/networkEndpointGroups/my-neg"
+  }
+}
+`, serviceName)
+}
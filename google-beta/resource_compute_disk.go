@@ -359,6 +359,16 @@ func resourceComputeDisk() *schema.Resource {
 					},
 				},
 			},
+			"resource_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					DiffSuppressFunc: compareSelfLinkOrResourceName,
+				},
+			},
 			"type": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -508,6 +518,12 @@ func resourceComputeDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	} else if v, ok := d.GetOkExists("source_snapshot_encryption_key"); !isEmptyValue(reflect.ValueOf(sourceSnapshotEncryptionKeyProp)) && (ok || !reflect.DeepEqual(v, sourceSnapshotEncryptionKeyProp)) {
 		obj["sourceSnapshotEncryptionKey"] = sourceSnapshotEncryptionKeyProp
 	}
+	resourcePoliciesProp, err := expandComputeDiskResourcePolicies(d.Get("resource_policies"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("resource_policies"); !isEmptyValue(reflect.ValueOf(resourcePoliciesProp)) && (ok || !reflect.DeepEqual(v, resourcePoliciesProp)) {
+		obj["resourcePolicies"] = resourcePoliciesProp
+	}
 
 	obj, err = resourceComputeDiskEncoder(d, meta, obj)
 	if err != nil {
@@ -610,6 +626,9 @@ func resourceComputeDiskRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("users", flattenComputeDiskUsers(res["users"], d)); err != nil {
 		return fmt.Errorf("Error reading Disk: %s", err)
 	}
+	if err := d.Set("resource_policies", flattenComputeDiskResourcePolicies(res["resourcePolicies"], d)); err != nil {
+		return fmt.Errorf("Error reading Disk: %s", err)
+	}
 	if err := d.Set("type", flattenComputeDiskType(res["type"], d)); err != nil {
 		return fmt.Errorf("Error reading Disk: %s", err)
 	}
@@ -909,6 +928,13 @@ func flattenComputeDiskUsers(v interface{}, d *schema.ResourceData) interface{}
 	return convertAndMapStringArr(v.([]interface{}), ConvertSelfLinkToV1)
 }
 
+func flattenComputeDiskResourcePolicies(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return convertAndMapStringArr(v.([]interface{}), ConvertSelfLinkToV1)
+}
+
 func flattenComputeDiskType(v interface{}, d *schema.ResourceData) interface{} {
 	if v == nil {
 		return v
@@ -1056,6 +1082,19 @@ func expandComputeDiskSize(v interface{}, d *schema.ResourceData, config *Config
 	return v, nil
 }
 
+func expandComputeDiskResourcePolicies(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		f, err := parseRegionalFieldValue("resourcePolicies", raw.(string), "project", "region", "zone", d, config, true)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for resource_policies: %s", err)
+		}
+		req = append(req, f.RelativeLink())
+	}
+	return req, nil
+}
+
 func expandComputeDiskType(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
 	f, err := parseZonalFieldValue("diskTypes", v.(string), "project", "zone", d, config, true)
 	if err != nil {
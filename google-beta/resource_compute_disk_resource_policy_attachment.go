@@ -0,0 +1,196 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v0.beta"
+)
+
+func resourceComputeDiskResourcePolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeDiskResourcePolicyAttachmentCreate,
+		Read:   resourceComputeDiskResourcePolicyAttachmentRead,
+		Delete: resourceComputeDiskResourcePolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeDiskResourcePolicyAttachmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"disk": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputeDiskResourcePolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	disk := GetResourceNameFromSelfLink(d.Get("disk").(string))
+	policy, err := ParseResourcePolicyFieldValue(d.Get("name").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	// A disk's resource policies are changed one at a time.
+	lockName := diskResourcePolicyLockName(project, zone, disk)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	req := &compute.DisksAddResourcePoliciesRequest{
+		ResourcePolicies: []string{policy.RelativeLink()},
+	}
+
+	log.Printf("[DEBUG] Adding resource policy %q to disk %q", policy.Name, disk)
+	op, err := config.clientComputeBeta.Disks.AddResourcePolicies(project, zone, disk, req).Do()
+	if err != nil {
+		return fmt.Errorf("Error adding resource policy %q to disk %q: %s", policy.Name, disk, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", project, zone, disk, policy.Name))
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), "Adding resource policy to disk")
+	if err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceComputeDiskResourcePolicyAttachmentRead(d, meta)
+}
+
+func resourceComputeDiskResourcePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	disk := GetResourceNameFromSelfLink(d.Get("disk").(string))
+	name := GetResourceNameFromSelfLink(d.Get("name").(string))
+
+	res, err := config.clientComputeBeta.Disks.Get(project, zone, disk).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Disk %q", disk))
+	}
+
+	for _, policy := range res.ResourcePolicies {
+		if GetResourceNameFromSelfLink(policy) == name {
+			d.Set("project", project)
+			d.Set("zone", zone)
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Removing DiskResourcePolicyAttachment %q because the policy isn't attached to the disk", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceComputeDiskResourcePolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	disk := GetResourceNameFromSelfLink(d.Get("disk").(string))
+	policy, err := ParseResourcePolicyFieldValue(d.Get("name").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	lockName := diskResourcePolicyLockName(project, zone, disk)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	req := &compute.DisksRemoveResourcePoliciesRequest{
+		ResourcePolicies: []string{policy.RelativeLink()},
+	}
+
+	log.Printf("[DEBUG] Removing resource policy %q from disk %q", policy.Name, disk)
+	op, err := config.clientComputeBeta.Disks.RemoveResourcePolicies(project, zone, disk, req).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DiskResourcePolicyAttachment %q", d.Id()))
+	}
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutDelete).Minutes()), "Removing resource policy from disk")
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceComputeDiskResourcePolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/disks/(?P<disk>[^/]+)/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<disk>[^/]+)/(?P<name>[^/]+)", "(?P<zone>[^/]+)/(?P<disk>[^/]+)/(?P<name>[^/]+)", "(?P<disk>[^/]+)/(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	id, err := replaceVars(d, config, "{{project}}/{{zone}}/{{disk}}/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func diskResourcePolicyLockName(project, zone, disk string) string {
+	return fmt.Sprintf("disk/%s/%s/%s/resourcePolicies", project, zone, disk)
+}
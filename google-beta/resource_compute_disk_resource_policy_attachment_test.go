@@ -0,0 +1,66 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeDiskResourcePolicyAttachment_basic(t *testing.T) {
+	t.Parallel()
+
+	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	policyName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDiskResourcePolicyAttachment_basic(diskName, policyName),
+			},
+			{
+				ResourceName:      "google_compute_disk_resource_policy_attachment.attachment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeDiskResourcePolicy_policy(policyName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_resource_policy" "policy" {
+  name   = "%s"
+  region = "us-central1"
+
+  snapshot_schedule_policy {
+    schedule {
+      hourly_schedule {
+        hours_in_cycle = 4
+        start_time     = "03:00"
+      }
+    }
+  }
+}
+`, policyName)
+}
+
+func testAccComputeDiskResourcePolicyAttachment_basic(diskName, policyName string) string {
+	return testAccComputeDiskResourcePolicy_policy(policyName) + fmt.Sprintf(`
+resource "google_compute_disk" "disk" {
+  name = "%s"
+  zone = "us-central1-a"
+  size = 10
+}
+
+resource "google_compute_disk_resource_policy_attachment" "attachment" {
+  name = "${google_compute_resource_policy.policy.name}"
+  disk = "${google_compute_disk.disk.name}"
+  zone = "us-central1-a"
+}
+`, diskName)
+}
@@ -13,6 +13,14 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_disk", testSweeper{
+		Dependencies: []string{"gcp_compute_instance"},
+		List:         listZonalComputeResources("disks"),
+		Delete:       sweepComputeResource,
+	})
+}
+
 func TestDiskImageDiffSuppress(t *testing.T) {
 	cases := map[string]struct {
 		Old, New           string
@@ -501,6 +509,29 @@ func TestAccComputeDisk_computeDiskUserRegex(t *testing.T) {
 
 }
 
+func TestAccComputeDisk_resourcePolicies(t *testing.T) {
+	t.Parallel()
+
+	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	policyName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDisk_resourcePolicies(diskName, policyName),
+			},
+			{
+				ResourceName:      "google_compute_disk.disk",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeDiskExists(n, p string, disk *compute.Disk) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -871,3 +902,14 @@ resource "google_compute_instance_group_manager" "manager" {
   target_size        = 1
 }`, diskName, mgrName)
 }
+
+func testAccComputeDisk_resourcePolicies(diskName, policyName string) string {
+	return testAccComputeDiskResourcePolicy_policy(policyName) + fmt.Sprintf(`
+resource "google_compute_disk" "disk" {
+  name              = "%s"
+  zone              = "us-central1-a"
+  size              = 10
+  resource_policies = ["${google_compute_resource_policy.policy.self_link}"]
+}
+`, diskName)
+}
@@ -0,0 +1,68 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeExternalVpnGateway_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeExternalVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeExternalVpnGateway_basic(acctest.RandString(10)),
+			},
+			{
+				ResourceName:      "google_compute_external_vpn_gateway.external_gateway",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeExternalVpnGateway_basic(val string) string {
+	return fmt.Sprintf(`
+resource "google_compute_external_vpn_gateway" "external_gateway" {
+  name            = "external-gateway-%s"
+  redundancy_type = "SINGLE_IP_INTERNALLY_REDUNDANT"
+  description     = "An externally managed VPN gateway"
+
+  interface {
+    id         = 0
+    ip_address = "8.8.8.8"
+  }
+}
+`, val,
+	)
+}
+
+func testAccCheckComputeExternalVpnGatewayDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_external_vpn_gateway" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/externalVpnGateways/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("ComputeExternalVpnGateway still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -14,6 +14,13 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_firewall", testSweeper{
+		List:   listGlobalComputeResources("firewalls"),
+		Delete: sweepComputeResource,
+	})
+}
+
 func TestAccComputeFirewall_basic(t *testing.T) {
 	t.Parallel()
 
@@ -8,6 +8,13 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_forwarding_rule", testSweeper{
+		List:   listRegionalComputeResources("forwardingRules"),
+		Delete: sweepComputeResource,
+	})
+}
+
 func TestAccComputeForwardingRule_update(t *testing.T) {
 	t.Parallel()
 
@@ -11,6 +11,14 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_global_address", testSweeper{
+		Dependencies: []string{"gcp_compute_global_forwarding_rule"},
+		List:         listGlobalComputeResources("addresses"),
+		Delete:       sweepComputeResource,
+	})
+}
+
 func TestAccComputeGlobalAddress_basic(t *testing.T) {
 	t.Parallel()
 
@@ -11,6 +11,13 @@ import (
 	computeBeta "google.golang.org/api/compute/v0.beta"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_global_forwarding_rule", testSweeper{
+		List:   listGlobalComputeResources("forwardingRules"),
+		Delete: sweepComputeResource,
+	})
+}
+
 func TestAccComputeGlobalForwardingRule_basic(t *testing.T) {
 	t.Parallel()
 
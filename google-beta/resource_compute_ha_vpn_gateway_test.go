@@ -0,0 +1,68 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeHaVpnGateway_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeHaVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeHaVpnGateway_basic(acctest.RandString(10)),
+			},
+			{
+				ResourceName:      "google_compute_ha_vpn_gateway.ha_gateway1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeHaVpnGateway_basic(val string) string {
+	return fmt.Sprintf(`
+resource "google_compute_ha_vpn_gateway" "ha_gateway1" {
+  region   = "us-central1"
+  name     = "ha-vpn-1-%s"
+  network  = "${google_compute_network.network1.self_link}"
+}
+
+resource "google_compute_network" "network1" {
+  name                    = "network1-%s"
+  auto_create_subnetworks = false
+}
+`, val, val,
+	)
+}
+
+func testAccCheckComputeHaVpnGatewayDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_ha_vpn_gateway" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://www.googleapis.com/compute/beta/projects/{{project}}/regions/{{region}}/vpnGateways/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("ComputeHaVpnGateway still exists at %s", url)
+		}
+	}
+
+	return nil
+}
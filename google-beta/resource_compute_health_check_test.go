@@ -11,6 +11,14 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_health_check", testSweeper{
+		Dependencies: []string{"gcp_compute_backend_service", "gcp_compute_instance_group_manager", "gcp_compute_region_instance_group_manager"},
+		List:         listGlobalComputeResources("healthChecks"),
+		Delete:       sweepComputeResource,
+	})
+}
+
 func TestAccComputeHealthCheck_tcp(t *testing.T) {
 	t.Parallel()
 
@@ -10,6 +10,14 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_http_health_check", testSweeper{
+		Dependencies: []string{"gcp_compute_backend_service", "gcp_compute_target_pool"},
+		List:         listGlobalComputeResources("httpHealthChecks"),
+		Delete:       sweepComputeResource,
+	})
+}
+
 func TestAccComputeHttpHealthCheck_basic(t *testing.T) {
 	t.Parallel()
 
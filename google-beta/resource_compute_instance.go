@@ -447,6 +447,13 @@ func resourceComputeInstance() *schema.Resource {
 							Default:  false,
 							ForceNew: true,
 						},
+
+						"node_affinities": &schema.Schema{
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     schedulingNodeAffinitiesElemSchema(),
+						},
 					},
 				},
 			},
@@ -545,6 +552,7 @@ func resourceComputeInstance() *schema.Resource {
 				},
 				suppressEmptyGuestAcceleratorDiff,
 			),
+			computeQuotaCheckCustomizeDiff(computeInstanceQuotaDemand),
 		),
 	}
 }
@@ -630,6 +638,7 @@ func expandComputeInstance(project string, zone *compute.Zone, d *schema.Resourc
 		AutomaticRestart:  googleapi.Bool(d.Get(prefix + ".automatic_restart").(bool)),
 		Preemptible:       d.Get(prefix + ".preemptible").(bool),
 		OnHostMaintenance: d.Get(prefix + ".on_host_maintenance").(string),
+		NodeAffinities:    expandSchedulingNodeAffinities(d.Get(prefix + ".node_affinities").(*schema.Set)),
 		ForceSendFields:   []string{"AutomaticRestart", "Preemptible"},
 	}
 
@@ -974,21 +983,24 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 	if d.HasChange("scheduling") {
 		prefix := "scheduling.0"
-		scheduling := &compute.Scheduling{
+		// SetScheduling replaces the whole policy, so node affinities are
+		// sent along unchanged to keep them from being cleared.
+		scheduling := &computeBeta.Scheduling{
 			AutomaticRestart:  googleapi.Bool(d.Get(prefix + ".automatic_restart").(bool)),
 			Preemptible:       d.Get(prefix + ".preemptible").(bool),
 			OnHostMaintenance: d.Get(prefix + ".on_host_maintenance").(string),
+			NodeAffinities:    expandSchedulingNodeAffinities(d.Get(prefix + ".node_affinities").(*schema.Set)),
 			ForceSendFields:   []string{"AutomaticRestart", "Preemptible"},
 		}
 
-		op, err := config.clientCompute.Instances.SetScheduling(project,
+		op, err := config.clientComputeBeta.Instances.SetScheduling(project,
 			zone, d.Id(), scheduling).Do()
 
 		if err != nil {
 			return fmt.Errorf("Error updating scheduling policy: %s", err)
 		}
 
-		opErr := computeOperationWaitTime(config.clientCompute, op, project, "scheduling policy update", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+		opErr := computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), "scheduling policy update")
 		if opErr != nil {
 			return opErr
 		}
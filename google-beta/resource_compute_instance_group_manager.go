@@ -29,6 +29,7 @@ func resourceComputeInstanceGroupManager() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceInstanceGroupManagerStateImporter,
 		},
+		CustomizeDiff: computeQuotaCheckCustomizeDiff(instanceGroupManagerQuotaDemand),
 
 		Schema: map[string]*schema.Schema{
 			"base_instance_name": &schema.Schema{
@@ -232,6 +233,26 @@ func resourceComputeInstanceGroupManager() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+
+			"stateful_disk": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"delete_rule": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "NEVER",
+							ValidateFunc: validation.StringInSlice([]string{"NEVER", "ON_PERMANENT_INSTANCE_DELETION"}, false),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -307,6 +328,13 @@ func resourceComputeInstanceGroupManagerCreate(d *schema.ResourceData, meta inte
 		return err
 	}
 
+	if d.Get("stateful_disk").(*schema.Set).Len() > 0 {
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/zones/%s/instanceGroupManagers/%s", project, zone, manager.Name)
+		if err := patchInstanceGroupManagerStatefulPolicy(config, d, url, project, int(d.Timeout(schema.TimeoutCreate).Minutes())); err != nil {
+			return err
+		}
+	}
+
 	return resourceComputeInstanceGroupManagerRead(d, meta)
 }
 
@@ -443,6 +471,13 @@ func resourceComputeInstanceGroupManagerRead(d *schema.ResourceData, meta interf
 	if err = d.Set("update_policy", flattenUpdatePolicy(manager.UpdatePolicy)); err != nil {
 		return fmt.Errorf("Error setting update_policy in state: %s", err.Error())
 	}
+	statefulDisks, err := readInstanceGroupManagerStatefulDisks(config, manager.SelfLink)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("stateful_disk", statefulDisks); err != nil {
+		return fmt.Errorf("Error setting stateful_disk in state: %s", err.Error())
+	}
 
 	if d.Get("wait_for_instances").(bool) {
 		conf := resource.StateChangeConf{
@@ -511,6 +546,13 @@ func resourceComputeInstanceGroupManagerUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
+	if d.HasChange("stateful_disk") {
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/zones/%s/instanceGroupManagers/%s", project, zone, d.Get("name").(string))
+		if err := patchInstanceGroupManagerStatefulPolicy(config, d, url, project, int(d.Timeout(schema.TimeoutUpdate).Minutes())); err != nil {
+			return err
+		}
+	}
+
 	// named ports can't be updated through PATCH
 	// so we call the update method on the instance group, instead of the igm
 	if d.HasChange("named_port") {
@@ -751,6 +793,93 @@ func flattenUpdatePolicy(updatePolicy *computeBeta.InstanceGroupManagerUpdatePol
 	return results
 }
 
+// The stateful policy isn't in the vendored compute client, so it's read and
+// written with requests to the manager's URL. Both the zonal and regional
+// managers use these.
+
+// expandStatefulPolicy returns the statefulPolicy of a PATCH request. PATCH
+// merges maps, so disks removed from the config are sent as null.
+func expandStatefulPolicy(d *schema.ResourceData) map[string]interface{} {
+	o, n := d.GetChange("stateful_disk")
+
+	disks := make(map[string]interface{})
+	for _, raw := range o.(*schema.Set).List() {
+		data := raw.(map[string]interface{})
+		disks[data["device_name"].(string)] = nil
+	}
+	for _, raw := range n.(*schema.Set).List() {
+		data := raw.(map[string]interface{})
+		disks[data["device_name"].(string)] = map[string]interface{}{
+			"autoDelete": data["delete_rule"].(string),
+		}
+	}
+
+	return map[string]interface{}{
+		"preservedState": map[string]interface{}{
+			"disks": disks,
+		},
+	}
+}
+
+func flattenStatefulPolicy(statefulPolicy interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+
+	policy, ok := statefulPolicy.(map[string]interface{})
+	if !ok {
+		return result
+	}
+	preservedState, ok := policy["preservedState"].(map[string]interface{})
+	if !ok {
+		return result
+	}
+	disks, ok := preservedState["disks"].(map[string]interface{})
+	if !ok {
+		return result
+	}
+
+	for deviceName, raw := range disks {
+		deleteRule := "NEVER"
+		if disk, ok := raw.(map[string]interface{}); ok {
+			if v, ok := disk["autoDelete"].(string); ok && v != "" {
+				deleteRule = v
+			}
+		}
+		result = append(result, map[string]interface{}{
+			"device_name": deviceName,
+			"delete_rule": deleteRule,
+		})
+	}
+	return result
+}
+
+func patchInstanceGroupManagerStatefulPolicy(config *Config, d *schema.ResourceData, url, project string, timeout int) error {
+	obj := map[string]interface{}{
+		"statefulPolicy": expandStatefulPolicy(d),
+	}
+
+	log.Printf("[DEBUG] Updating stateful policy of %q: %#v", url, obj)
+	res, err := sendRequest(config, "PATCH", url, obj)
+	if err != nil {
+		return fmt.Errorf("Error updating stateful policy: %s", err)
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	return computeOperationWaitTime(config.clientCompute, op, project, "Updating stateful policy", timeout)
+}
+
+func readInstanceGroupManagerStatefulDisks(config *Config, url string) ([]map[string]interface{}, error) {
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading stateful policy: %s", err)
+	}
+
+	return flattenStatefulPolicy(res["statefulPolicy"]), nil
+}
+
 func resourceInstanceGroupManagerStateImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("wait_for_instances", false)
 	zonalID, err := parseInstanceGroupManagerId(d.Id())
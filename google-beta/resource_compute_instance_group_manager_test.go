@@ -9,6 +9,13 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_instance_group_manager", testSweeper{
+		List:   listZonalComputeResources("instanceGroupManagers"),
+		Delete: sweepComputeResource,
+	})
+}
+
 func TestAccInstanceGroupManager_basic(t *testing.T) {
 	t.Parallel()
 
@@ -260,6 +267,48 @@ func TestAccInstanceGroupManager_autoHealingPolicies(t *testing.T) {
 	})
 }
 
+func TestAccInstanceGroupManager_stateful(t *testing.T) {
+	t.Parallel()
+
+	template := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceGroupManagerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceGroupManager_stateful(template, igm, "NEVER"),
+			},
+			{
+				ResourceName:            "google_compute_instance_group_manager.igm-stateful",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_instances"},
+			},
+			{
+				Config: testAccInstanceGroupManager_stateful(template, igm, "ON_PERMANENT_INSTANCE_DELETION"),
+			},
+			{
+				ResourceName:            "google_compute_instance_group_manager.igm-stateful",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_instances"},
+			},
+			{
+				Config: testAccInstanceGroupManager_statelessAgain(template, igm),
+			},
+			{
+				ResourceName:            "google_compute_instance_group_manager.igm-stateful",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_instances"},
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceGroupManagerDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -1034,3 +1083,70 @@ resource "google_compute_instance_group_manager" "igm-basic" {
 }
 	`, primaryTemplate, canaryTemplate, igm)
 }
+
+func testAccInstanceGroupManager_statefulTemplate(template string) string {
+	return fmt.Sprintf(`
+	data "google_compute_image" "my_image" {
+		family  = "debian-9"
+		project = "debian-cloud"
+	}
+
+	resource "google_compute_instance_template" "igm-stateful" {
+		name = "%s"
+		machine_type = "n1-standard-1"
+
+		disk {
+			source_image = "${data.google_compute_image.my_image.self_link}"
+			auto_delete = true
+			boot = true
+		}
+
+		disk {
+			device_name = "data"
+			disk_size_gb = 10
+			auto_delete = false
+		}
+
+		network_interface {
+			network = "default"
+		}
+	}
+	`, template)
+}
+
+func testAccInstanceGroupManager_stateful(template, igm, deleteRule string) string {
+	return testAccInstanceGroupManager_statefulTemplate(template) + fmt.Sprintf(`
+	resource "google_compute_instance_group_manager" "igm-stateful" {
+		description = "Terraform test instance group manager"
+		name = "%s"
+		version {
+			name = "prod"
+			instance_template = "${google_compute_instance_template.igm-stateful.self_link}"
+		}
+		base_instance_name = "igm-stateful"
+		zone = "us-central1-c"
+		target_size = 1
+
+		stateful_disk {
+			device_name = "data"
+			delete_rule = "%s"
+		}
+	}
+	`, igm, deleteRule)
+}
+
+func testAccInstanceGroupManager_statelessAgain(template, igm string) string {
+	return testAccInstanceGroupManager_statefulTemplate(template) + fmt.Sprintf(`
+	resource "google_compute_instance_group_manager" "igm-stateful" {
+		description = "Terraform test instance group manager"
+		name = "%s"
+		version {
+			name = "prod"
+			instance_template = "${google_compute_instance_template.igm-stateful.self_link}"
+		}
+		base_instance_name = "igm-stateful"
+		zone = "us-central1-c"
+		target_size = 1
+	}
+	`, igm)
+}
@@ -344,6 +344,13 @@ func resourceComputeInstanceTemplate() *schema.Resource {
 							Computed: true,
 							ForceNew: true,
 						},
+
+						"node_affinities": &schema.Schema{
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     schedulingNodeAffinitiesElemSchema(),
+						},
 					},
 				},
 			},
@@ -662,6 +669,10 @@ func resourceComputeInstanceTemplateCreate(d *schema.ResourceData, meta interfac
 				forceSendFieldsScheduling = append(forceSendFieldsScheduling, "OnHostMaintenance")
 			}
 		}
+
+		if vp, okp := _scheduling["node_affinities"]; okp {
+			instanceProperties.Scheduling.NodeAffinities = expandSchedulingNodeAffinities(vp.(*schema.Set))
+		}
 	}
 	instanceProperties.Scheduling.ForceSendFields = forceSendFieldsScheduling
 
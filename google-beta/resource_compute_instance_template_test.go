@@ -12,6 +12,14 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_instance_template", testSweeper{
+		Dependencies: []string{"gcp_compute_instance_group_manager", "gcp_compute_region_instance_group_manager"},
+		List:         listGlobalComputeResources("instanceTemplates"),
+		Delete:       sweepComputeResource,
+	})
+}
+
 const DEFAULT_MIN_CPU_TEST_VALUE = "Intel Haswell"
 
 func TestAccComputeInstanceTemplate_basic(t *testing.T) {
@@ -13,6 +13,14 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_instance", testSweeper{
+		Dependencies: []string{"gcp_compute_instance_group_manager", "gcp_compute_region_instance_group_manager"},
+		List:         listZonalComputeResources("instances"),
+		Delete:       sweepComputeResource,
+	})
+}
+
 func computeInstanceImportStep(zone, instanceName string, additionalImportIgnores []string) resource.TestStep {
 	// metadata is only read into state if set in the config
 	// since importing doesn't know whether metadata.startup_script vs metadata_startup_script is set in the config,
@@ -0,0 +1,462 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	compute "google.golang.org/api/compute/v1"
+)
+
+// Suppress diffs between a domain and its fully qualified form, as the API may
+// return domains with a trailing dot.
+func absoluteDomainSuppress(k, old, new string, _ *schema.ResourceData) bool {
+	return strings.TrimSuffix(old, ".") == strings.TrimSuffix(new, ".")
+}
+
+func resourceComputeManagedSslCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeManagedSslCertificateCreate,
+		Read:   resourceComputeManagedSslCertificateRead,
+		Update: resourceComputeManagedSslCertificateUpdate,
+		Delete: resourceComputeManagedSslCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeManagedSslCertificateImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(360 * time.Second),
+			Delete: schema.DefaultTimeout(360 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateGCPName,
+			},
+			"managed": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domains": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								DiffSuppressFunc: absoluteDomainSuppress,
+							},
+						},
+						"domain_status": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"MANAGED", ""}, false),
+				Default:      "MANAGED",
+			},
+			"certificate_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expire_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject_alternative_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"wait_for_active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"self_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeManagedSslCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandComputeManagedSslCertificateDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	nameProp, err := expandComputeManagedSslCertificateName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	managedProp, err := expandComputeManagedSslCertificateManaged(d.Get("managed"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("managed"); !isEmptyValue(reflect.ValueOf(managedProp)) && (ok || !reflect.DeepEqual(v, managedProp)) {
+		obj["managed"] = managedProp
+	}
+	typeProp, err := expandComputeManagedSslCertificateType(d.Get("type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("type"); !isEmptyValue(reflect.ValueOf(typeProp)) && (ok || !reflect.DeepEqual(v, typeProp)) {
+		obj["type"] = typeProp
+	}
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/sslCertificates")
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	log.Printf("[DEBUG] Creating new ManagedSslCertificate: %#v", obj)
+	res, err := sendRequest(config, "POST", url, obj)
+	if err != nil {
+		return fmt.Errorf("Error creating ManagedSslCertificate: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	waitErr := computeOperationWaitTime(
+		config.clientCompute, op, project, "Creating ManagedSslCertificate",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create ManagedSslCertificate: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating ManagedSslCertificate %q: %#v", d.Id(), res)
+
+	if d.Get("wait_for_active").(bool) {
+		// The insert operation has already used part of the create timeout.
+		remaining := d.Timeout(schema.TimeoutCreate) - time.Since(start)
+		if err := resourceComputeManagedSslCertificateWaitForActive(d, config, remaining); err != nil {
+			return err
+		}
+	}
+
+	return resourceComputeManagedSslCertificateRead(d, meta)
+}
+
+// Managed certificates are only provisioned once their domains resolve to a
+// load balancer that serves them, which can take far longer than the insert
+// operation. If the certificate is still provisioning when timeout passes we
+// log and carry on rather than tainting the resource, since recreating it
+// would only restart provisioning.
+func resourceComputeManagedSslCertificateWaitForActive(d *schema.ResourceData, config *Config, timeout time.Duration) error {
+	if timeout <= 0 {
+		log.Printf("[WARN] Create timeout already passed, not waiting for ManagedSslCertificate %q to become ACTIVE", d.Id())
+		return nil
+	}
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/sslCertificates/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PROVISIONING", "PROVISIONING_FAILED", "MANAGED_CERTIFICATE_STATUS_UNSPECIFIED"},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			res, err := sendRequest(config, "GET", url, nil)
+			if err != nil {
+				return nil, "", err
+			}
+			status := "MANAGED_CERTIFICATE_STATUS_UNSPECIFIED"
+			if managed, ok := res["managed"].(map[string]interface{}); ok {
+				if v, ok := managed["status"].(string); ok && v != "" {
+					status = v
+				}
+			}
+			return res, status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for ManagedSslCertificate %q to become ACTIVE", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		if _, ok := err.(*resource.TimeoutError); ok {
+			log.Printf("[WARN] ManagedSslCertificate %q is not ACTIVE yet: %s", d.Id(), err)
+			return nil
+		}
+		return fmt.Errorf("Error waiting for ManagedSslCertificate %q to become ACTIVE: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceComputeManagedSslCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/sslCertificates/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeManagedSslCertificate %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+
+	if err := d.Set("creation_timestamp", flattenComputeManagedSslCertificateCreationTimestamp(res["creationTimestamp"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("description", flattenComputeManagedSslCertificateDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("certificate_id", flattenComputeManagedSslCertificateCertificate_id(res["id"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("name", flattenComputeManagedSslCertificateName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("managed", flattenComputeManagedSslCertificateManaged(res["managed"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("type", flattenComputeManagedSslCertificateType(res["type"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("subject_alternative_names", flattenComputeManagedSslCertificateSubjectAlternativeNames(res["subjectAlternativeNames"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("expire_time", flattenComputeManagedSslCertificateExpireTime(res["expireTime"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("self_link", ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeManagedSslCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only wait_for_active can change in place, and it only affects Create.
+	return resourceComputeManagedSslCertificateRead(d, meta)
+}
+
+func resourceComputeManagedSslCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/sslCertificates/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting ManagedSslCertificate %q", d.Id())
+	res, err := sendRequest(config, "DELETE", url, obj)
+	if err != nil {
+		return handleNotFoundError(err, d, "ManagedSslCertificate")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config.clientCompute, op, project, "Deleting ManagedSslCertificate",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting ManagedSslCertificate %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputeManagedSslCertificateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	parseImportId([]string{"projects/(?P<project>[^/]+)/global/sslCertificates/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config)
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// wait_for_active is only stored in state
+	d.Set("wait_for_active", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenComputeManagedSslCertificateCreationTimestamp(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateCertificate_id(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeManagedSslCertificateName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateManaged(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["domains"] =
+		flattenComputeManagedSslCertificateManagedDomains(original["domains"], d)
+	transformed["status"] =
+		flattenComputeManagedSslCertificateManagedStatus(original["status"], d)
+	transformed["domain_status"] =
+		flattenComputeManagedSslCertificateManagedDomainStatus(original["domainStatus"], d)
+	return []interface{}{transformed}
+}
+func flattenComputeManagedSslCertificateManagedDomains(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateManagedStatus(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateManagedDomainStatus(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateSubjectAlternativeNames(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateExpireTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandComputeManagedSslCertificateDescription(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeManagedSslCertificateName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeManagedSslCertificateManaged(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedDomains, err := expandComputeManagedSslCertificateManagedDomains(original["domains"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDomains); val.IsValid() && !isEmptyValue(val) {
+		transformed["domains"] = transformedDomains
+	}
+
+	return transformed, nil
+}
+
+func expandComputeManagedSslCertificateManagedDomains(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeManagedSslCertificateType(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
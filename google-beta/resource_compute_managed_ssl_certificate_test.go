@@ -0,0 +1,155 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeManagedSslCertificate_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeManagedSslCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeManagedSslCertificate_basic(acctest.RandString(10)),
+			},
+			{
+				ResourceName:      "google_compute_managed_ssl_certificate.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// The certificate's domain never resolves to the load balancer, so it stays
+// PROVISIONING and Create should give up waiting without failing once the
+// short create timeout passes.
+func TestAccComputeManagedSslCertificate_waitForActiveTimeout(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeManagedSslCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeManagedSslCertificate_waitForActive(acctest.RandString(10)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_managed_ssl_certificate.default", "wait_for_active", "true"),
+					resource.TestCheckResourceAttr("google_compute_managed_ssl_certificate.default", "managed.0.status", "PROVISIONING"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeManagedSslCertificate_basic(val string) string {
+	return fmt.Sprintf(`
+resource "google_compute_managed_ssl_certificate" "default" {
+  name = "test-cert-%s"
+
+  managed {
+    domains = ["sslcert.tf-test.club."]
+  }
+}
+
+resource "google_compute_target_https_proxy" "default" {
+  name             = "test-proxy-%s"
+  url_map          = "${google_compute_url_map.default.self_link}"
+  ssl_certificates = ["${google_compute_managed_ssl_certificate.default.self_link}"]
+}
+
+resource "google_compute_url_map" "default" {
+  name        = "url-map-%s"
+  description = "a description"
+
+  default_service = "${google_compute_backend_service.default.self_link}"
+
+  host_rule {
+    hosts        = ["sslcert.tf-test.club"]
+    path_matcher = "allpaths"
+  }
+
+  path_matcher {
+    name            = "allpaths"
+    default_service = "${google_compute_backend_service.default.self_link}"
+
+    path_rule {
+      paths   = ["/*"]
+      service = "${google_compute_backend_service.default.self_link}"
+    }
+  }
+}
+
+resource "google_compute_backend_service" "default" {
+  name        = "backend-service-%s"
+  port_name   = "http"
+  protocol    = "HTTP"
+  timeout_sec = 10
+
+  health_checks = ["${google_compute_http_health_check.default.self_link}"]
+}
+
+resource "google_compute_http_health_check" "default" {
+  name               = "http-health-check-%s"
+  request_path       = "/"
+  check_interval_sec = 1
+  timeout_sec        = 1
+}
+
+resource "google_compute_global_forwarding_rule" "default" {
+  name       = "forwarding-rule-%s"
+  target     = "${google_compute_target_https_proxy.default.self_link}"
+  port_range = 443
+}
+`, val, val, val, val, val, val,
+	)
+}
+
+func testAccComputeManagedSslCertificate_waitForActive(val string) string {
+	return fmt.Sprintf(`
+resource "google_compute_managed_ssl_certificate" "default" {
+  name            = "test-cert-%s"
+  wait_for_active = true
+
+  managed {
+    domains = ["sslcert.tf-test.club."]
+  }
+
+  timeouts {
+    create = "1m"
+  }
+}
+`, val,
+	)
+}
+
+func testAccCheckComputeManagedSslCertificateDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_managed_ssl_certificate" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/sslCertificates/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("ComputeManagedSslCertificate still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,242 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v0.beta"
+)
+
+func resourceComputeNetworkEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeNetworkEndpointCreate,
+		Read:   resourceComputeNetworkEndpointRead,
+		Delete: resourceComputeNetworkEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeNetworkEndpointImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Minute),
+			Delete: schema.DefaultTimeout(6 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_endpoint_group": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"instance": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"ip_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"zone": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputeNetworkEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	neg, err := ParseNetworkEndpointGroupFieldValue(d.Get("network_endpoint_group").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	endpoint := expandNetworkEndpoint(d)
+
+	// Endpoints of a group are attached one request at a time.
+	lockName := networkEndpointGroupLockName(neg)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	req := &compute.NetworkEndpointGroupsAttachEndpointsRequest{
+		NetworkEndpoints: []*compute.NetworkEndpoint{endpoint},
+	}
+
+	log.Printf("[DEBUG] Attaching network endpoint %#v to NetworkEndpointGroup %q", endpoint, neg.Name)
+	op, err := config.clientComputeBeta.NetworkEndpointGroups.AttachNetworkEndpoints(neg.Project, neg.Zone, neg.Name, req).Do()
+	if err != nil {
+		return errwrap.Wrapf("Error attaching network endpoint: {{err}}", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s/%s/%d", neg.Project, neg.Zone, neg.Name, endpoint.Instance, endpoint.IpAddress, endpoint.Port))
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, neg.Project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Attaching network endpoint to NetworkEndpointGroup %q", neg.Name))
+	if err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceComputeNetworkEndpointRead(d, meta)
+}
+
+func resourceComputeNetworkEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseNetworkEndpointId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var found *compute.NetworkEndpoint
+	err = config.clientComputeBeta.NetworkEndpointGroups.ListNetworkEndpoints(id.Project, id.Zone, id.NetworkEndpointGroup, &compute.NetworkEndpointGroupsListEndpointsRequest{}).Pages(context.Background(), func(res *compute.NetworkEndpointGroupsListNetworkEndpoints) error {
+		for _, item := range res.Items {
+			e := item.NetworkEndpoint
+			if e != nil && GetResourceNameFromSelfLink(e.Instance) == id.Instance && e.IpAddress == id.IpAddress && e.Port == id.Port {
+				found = e
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("NetworkEndpointGroup %q", id.NetworkEndpointGroup))
+	}
+
+	if found == nil {
+		log.Printf("[WARN] Removing NetworkEndpoint %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_endpoint_group", id.NetworkEndpointGroup)
+	d.Set("instance", GetResourceNameFromSelfLink(found.Instance))
+	d.Set("ip_address", found.IpAddress)
+	d.Set("port", found.Port)
+	d.Set("zone", id.Zone)
+	d.Set("project", id.Project)
+
+	return nil
+}
+
+func resourceComputeNetworkEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseNetworkEndpointId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	neg := &ZonalFieldValue{Project: id.Project, Zone: id.Zone, Name: id.NetworkEndpointGroup}
+	lockName := networkEndpointGroupLockName(neg)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	req := &compute.NetworkEndpointGroupsDetachEndpointsRequest{
+		NetworkEndpoints: []*compute.NetworkEndpoint{
+			{
+				Instance:  id.Instance,
+				IpAddress: id.IpAddress,
+				Port:      id.Port,
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Detaching network endpoint %q", d.Id())
+	op, err := config.clientComputeBeta.NetworkEndpointGroups.DetachNetworkEndpoints(id.Project, id.Zone, id.NetworkEndpointGroup, req).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("NetworkEndpoint %q", d.Id()))
+	}
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, id.Project, int(d.Timeout(schema.TimeoutDelete).Minutes()), fmt.Sprintf("Detaching network endpoint from NetworkEndpointGroup %q", id.NetworkEndpointGroup))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceComputeNetworkEndpointImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/networkEndpointGroups/(?P<network_endpoint_group>[^/]+)/(?P<instance>[^/]+)/(?P<ip_address>[^/]+)/(?P<port>[^/]+)", "(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<network_endpoint_group>[^/]+)/(?P<instance>[^/]+)/(?P<ip_address>[^/]+)/(?P<port>[^/]+)", "(?P<zone>[^/]+)/(?P<network_endpoint_group>[^/]+)/(?P<instance>[^/]+)/(?P<ip_address>[^/]+)/(?P<port>[^/]+)", "(?P<network_endpoint_group>[^/]+)/(?P<instance>[^/]+)/(?P<ip_address>[^/]+)/(?P<port>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	id, err := replaceVars(d, config, "{{project}}/{{zone}}/{{network_endpoint_group}}/{{instance}}/{{ip_address}}/{{port}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandNetworkEndpoint(d *schema.ResourceData) *compute.NetworkEndpoint {
+	return &compute.NetworkEndpoint{
+		Instance:  GetResourceNameFromSelfLink(d.Get("instance").(string)),
+		IpAddress: d.Get("ip_address").(string),
+		Port:      int64(d.Get("port").(int)),
+	}
+}
+
+func networkEndpointGroupLockName(neg *ZonalFieldValue) string {
+	return fmt.Sprintf("networkEndpointGroup/%s/%s/%s", neg.Project, neg.Zone, neg.Name)
+}
+
+type networkEndpointId struct {
+	Project              string
+	Zone                 string
+	NetworkEndpointGroup string
+	Instance             string
+	IpAddress            string
+	Port                 int64
+}
+
+func parseNetworkEndpointId(id string) (*networkEndpointId, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 6 {
+		return nil, fmt.Errorf("Invalid google_compute_network_endpoint id format, expecting `{project}/{zone}/{network_endpoint_group}/{instance}/{ip_address}/{port}`, found %s", id)
+	}
+
+	port, err := strconv.ParseInt(parts[5], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid port %q in google_compute_network_endpoint id %s", parts[5], id)
+	}
+
+	return &networkEndpointId{
+		Project:              parts[0],
+		Zone:                 parts[1],
+		NetworkEndpointGroup: parts[2],
+		Instance:             parts[3],
+		IpAddress:            parts[4],
+		Port:                 port,
+	}, nil
+}
@@ -0,0 +1,230 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/compute/v0.beta"
+)
+
+func resourceComputeNetworkEndpointGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeNetworkEndpointGroupCreate,
+		Read:   resourceComputeNetworkEndpointGroupRead,
+		Delete: resourceComputeNetworkEndpointGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeNetworkEndpointGroupImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateGCPName,
+			},
+
+			"network": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"subnetwork": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"default_port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"network_endpoint_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "GCE_VM_IP_PORT",
+				ValidateFunc: validation.StringInSlice([]string{"GCE_VM_IP_PORT"}, false),
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"zone": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"self_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeNetworkEndpointGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	network, err := ParseNetworkFieldValue(d.Get("network").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	subnetwork, err := ParseSubnetworkFieldValue(d.Get("subnetwork").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	neg := &compute.NetworkEndpointGroup{
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		Network:             network.RelativeLink(),
+		Subnetwork:          subnetwork.RelativeLink(),
+		DefaultPort:         int64(d.Get("default_port").(int)),
+		NetworkEndpointType: d.Get("network_endpoint_type").(string),
+	}
+
+	log.Printf("[DEBUG] NetworkEndpointGroup insert request: %#v", neg)
+	op, err := config.clientComputeBeta.NetworkEndpointGroups.Insert(project, zone, neg).Do()
+	if err != nil {
+		return errwrap.Wrapf("Error creating NetworkEndpointGroup: {{err}}", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", project, zone, neg.Name))
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Creating NetworkEndpointGroup %q", neg.Name))
+	if err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceComputeNetworkEndpointGroupRead(d, meta)
+}
+
+func resourceComputeNetworkEndpointGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseNetworkEndpointGroupId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	neg, err := config.clientComputeBeta.NetworkEndpointGroups.Get(id.Project, id.Zone, id.Name).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("NetworkEndpointGroup %q", id.Name))
+	}
+
+	d.Set("name", neg.Name)
+	d.Set("description", neg.Description)
+	d.Set("network", ConvertSelfLinkToV1(neg.Network))
+	d.Set("subnetwork", ConvertSelfLinkToV1(neg.Subnetwork))
+	d.Set("default_port", neg.DefaultPort)
+	d.Set("network_endpoint_type", neg.NetworkEndpointType)
+	d.Set("size", neg.Size)
+	d.Set("zone", GetResourceNameFromSelfLink(neg.Zone))
+	d.Set("project", id.Project)
+	d.Set("self_link", ConvertSelfLinkToV1(neg.SelfLink))
+
+	return nil
+}
+
+func resourceComputeNetworkEndpointGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseNetworkEndpointGroupId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	op, err := config.clientComputeBeta.NetworkEndpointGroups.Delete(id.Project, id.Zone, id.Name).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("NetworkEndpointGroup %q", id.Name))
+	}
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, id.Project, int(d.Timeout(schema.TimeoutDelete).Minutes()), fmt.Sprintf("Deleting NetworkEndpointGroup %q", id.Name))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceComputeNetworkEndpointGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/networkEndpointGroups/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)", "(?P<zone>[^/]+)/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	id, err := replaceVars(d, config, "{{project}}/{{zone}}/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type networkEndpointGroupId struct {
+	Project string
+	Zone    string
+	Name    string
+}
+
+func parseNetworkEndpointGroupId(id string) (*networkEndpointGroupId, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid google_compute_network_endpoint_group id format, expecting `{project}/{zone}/{name}`, found %s", id)
+	}
+
+	return &networkEndpointGroupId{
+		Project: parts[0],
+		Zone:    parts[1],
+		Name:    parts[2],
+	}, nil
+}
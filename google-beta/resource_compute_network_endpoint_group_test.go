@@ -0,0 +1,125 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeNetworkEndpointGroup_networkEndpoints(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNetworkEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeNetworkEndpointGroup_networkEndpoints(suffix, 1),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_network_endpoint_group.neg",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// size tracks the endpoints attached after the group was read.
+				ImportStateVerifyIgnore: []string{"size"},
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_network_endpoint.endpoint.0",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccComputeNetworkEndpointGroup_networkEndpoints(suffix, 2),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_network_endpoint.endpoint.1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeNetworkEndpointGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_network_endpoint_group" {
+			continue
+		}
+
+		id, err := parseNetworkEndpointGroupId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = config.clientComputeBeta.NetworkEndpointGroups.Get(id.Project, id.Zone, id.Name).Do()
+		if err == nil {
+			return fmt.Errorf("NetworkEndpointGroup %q still exists", id.Name)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeNetworkEndpointGroup_networkEndpoints(suffix string, count int) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+  family  = "debian-9"
+  project = "debian-cloud"
+}
+
+resource "google_compute_network" "default" {
+  name                    = "tf-test-neg-%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "tf-test-neg-%s"
+  ip_cidr_range = "10.0.0.0/16"
+  region        = "us-central1"
+  network       = "${google_compute_network.default.self_link}"
+}
+
+resource "google_compute_instance" "endpoint" {
+  count        = 2
+  name         = "tf-test-neg-%s-${count.index}"
+  machine_type = "n1-standard-1"
+  zone         = "us-central1-a"
+
+  boot_disk {
+    initialize_params {
+      image = "${data.google_compute_image.my_image.self_link}"
+    }
+  }
+
+  network_interface {
+    subnetwork = "${google_compute_subnetwork.default.self_link}"
+  }
+}
+
+resource "google_compute_network_endpoint_group" "neg" {
+  name         = "tf-test-neg-%s"
+  network      = "${google_compute_network.default.self_link}"
+  subnetwork   = "${google_compute_subnetwork.default.self_link}"
+  default_port = "90"
+  zone         = "us-central1-a"
+}
+
+resource "google_compute_network_endpoint" "endpoint" {
+  count                  = %d
+  network_endpoint_group = "${google_compute_network_endpoint_group.neg.name}"
+  zone                   = "us-central1-a"
+
+  instance   = "${element(google_compute_instance.endpoint.*.name, count.index)}"
+  ip_address = "${element(google_compute_instance.endpoint.*.network_interface.0.network_ip, count.index)}"
+  port       = "${google_compute_network_endpoint_group.neg.default_port}"
+}
+`, suffix, suffix, suffix, suffix, count)
+}
@@ -10,6 +10,14 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_network", testSweeper{
+		Dependencies: []string{"gcp_compute_subnetwork", "gcp_compute_firewall", "gcp_compute_route", "gcp_compute_router", "gcp_compute_global_address", "gcp_container_cluster", "gcp_dns_managed_zone", "gcp_redis_instance"},
+		List:         listGlobalComputeResources("networks"),
+		Delete:       sweepComputeResource,
+	})
+}
+
 func TestAccComputeNetwork_basic(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,265 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/customdiff"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v0.beta"
+)
+
+func resourceComputeNodeGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeNodeGroupCreate,
+		Read:   resourceComputeNodeGroupRead,
+		Update: resourceComputeNodeGroupUpdate,
+		Delete: resourceComputeNodeGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeNodeGroupImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		// Nodes can be added to a group in place, but removing them means
+		// choosing which nodes, and the instances on them, to delete.
+		CustomizeDiff: customdiff.ForceNewIfChange("size", func(old, new, meta interface{}) bool {
+			return new.(int) < old.(int)
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateGCPName,
+			},
+
+			"node_template": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"zone": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"creation_timestamp": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"self_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeNodeGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	nodeTemplate, err := ParseNodeTemplateFieldValue(d.Get("node_template").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	nodeGroup := &compute.NodeGroup{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		NodeTemplate: nodeTemplate.RelativeLink(),
+	}
+	size := int64(d.Get("size").(int))
+
+	log.Printf("[DEBUG] NodeGroup insert request: %#v", nodeGroup)
+	op, err := config.clientComputeBeta.NodeGroups.Insert(project, zone, size, nodeGroup).Do()
+	if err != nil {
+		return errwrap.Wrapf("Error creating NodeGroup: {{err}}", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", project, zone, nodeGroup.Name))
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Creating NodeGroup %q", nodeGroup.Name))
+	if err != nil {
+		return err
+	}
+
+	return resourceComputeNodeGroupRead(d, meta)
+}
+
+func resourceComputeNodeGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseNodeGroupId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	nodeGroup, err := config.clientComputeBeta.NodeGroups.Get(id.Project, id.Zone, id.Name).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("NodeGroup %q", id.Name))
+	}
+
+	d.Set("name", nodeGroup.Name)
+	d.Set("description", nodeGroup.Description)
+	d.Set("node_template", ConvertSelfLinkToV1(nodeGroup.NodeTemplate))
+	d.Set("size", nodeGroup.Size)
+	d.Set("zone", GetResourceNameFromSelfLink(nodeGroup.Zone))
+	d.Set("project", id.Project)
+	d.Set("creation_timestamp", nodeGroup.CreationTimestamp)
+	d.Set("self_link", ConvertSelfLinkToV1(nodeGroup.SelfLink))
+
+	return nil
+}
+
+func resourceComputeNodeGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseNodeGroupId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Partial(true)
+
+	if d.HasChange("node_template") {
+		nodeTemplate, err := ParseNodeTemplateFieldValue(d.Get("node_template").(string), d, config)
+		if err != nil {
+			return err
+		}
+
+		req := &compute.NodeGroupsSetNodeTemplateRequest{
+			NodeTemplate: nodeTemplate.RelativeLink(),
+		}
+		op, err := config.clientComputeBeta.NodeGroups.SetNodeTemplate(id.Project, id.Zone, id.Name, req).Do()
+		if err != nil {
+			return errwrap.Wrapf("Error updating NodeGroup node template: {{err}}", err)
+		}
+
+		err = computeSharedOperationWaitTime(config.clientCompute, op, id.Project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), fmt.Sprintf("Updating NodeGroup %q", id.Name))
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("node_template")
+	}
+
+	if d.HasChange("size") {
+		o, n := d.GetChange("size")
+		req := &compute.NodeGroupsAddNodesRequest{
+			AdditionalNodeCount: int64(n.(int) - o.(int)),
+		}
+		op, err := config.clientComputeBeta.NodeGroups.AddNodes(id.Project, id.Zone, id.Name, req).Do()
+		if err != nil {
+			return errwrap.Wrapf("Error adding nodes to NodeGroup: {{err}}", err)
+		}
+
+		err = computeSharedOperationWaitTime(config.clientCompute, op, id.Project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), fmt.Sprintf("Adding nodes to NodeGroup %q", id.Name))
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("size")
+	}
+
+	d.Partial(false)
+
+	return resourceComputeNodeGroupRead(d, meta)
+}
+
+func resourceComputeNodeGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseNodeGroupId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	op, err := config.clientComputeBeta.NodeGroups.Delete(id.Project, id.Zone, id.Name).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("NodeGroup %q", id.Name))
+	}
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, id.Project, int(d.Timeout(schema.TimeoutDelete).Minutes()), fmt.Sprintf("Deleting NodeGroup %q", id.Name))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceComputeNodeGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/nodeGroups/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)", "(?P<zone>[^/]+)/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	id, err := replaceVars(d, config, "{{project}}/{{zone}}/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type nodeGroupId struct {
+	Project string
+	Zone    string
+	Name    string
+}
+
+func parseNodeGroupId(id string) (*nodeGroupId, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid google_compute_node_group id format, expecting `{project}/{zone}/{name}`, found %s", id)
+	}
+
+	return &nodeGroupId{
+		Project: parts[0],
+		Zone:    parts[1],
+		Name:    parts[2],
+	}, nil
+}
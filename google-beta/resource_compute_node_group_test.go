@@ -0,0 +1,168 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeNodeGroup_update(t *testing.T) {
+	t.Parallel()
+
+	groupName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	tmplPrefix := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNodeGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeNodeGroup_update(groupName, tmplPrefix, "tmpl1", 1),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_node_group.nodes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccComputeNodeGroup_update(groupName, tmplPrefix, "tmpl2", 2),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_node_group.nodes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeNodeGroup_instanceNodeAffinities(t *testing.T) {
+	t.Parallel()
+
+	groupName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	instanceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNodeGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeNodeGroup_instanceNodeAffinities(groupName, instanceName),
+			},
+			resource.TestStep{
+				ResourceName:            "google_compute_instance.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"boot_disk.0.initialize_params.0.image"},
+			},
+		},
+	})
+}
+
+func testAccCheckComputeNodeGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_node_group" {
+			continue
+		}
+
+		id, err := parseNodeGroupId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = config.clientComputeBeta.NodeGroups.Get(id.Project, id.Zone, id.Name).Do()
+		if err == nil {
+			return fmt.Errorf("NodeGroup %q still exists", id.Name)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeNodeGroup_update(groupName, tmplPrefix, tmplToUse string, size int) string {
+	return fmt.Sprintf(`
+resource "google_compute_node_template" "tmpl1" {
+  name      = "%s-first"
+  region    = "us-central1"
+  node_type = "n1-node-96-624"
+}
+
+resource "google_compute_node_template" "tmpl2" {
+  name      = "%s-second"
+  region    = "us-central1"
+  node_type = "n1-node-96-624"
+}
+
+resource "google_compute_node_group" "nodes" {
+  name        = "%s"
+  zone        = "us-central1-a"
+  description = "example google_compute_node_group for Terraform Google Provider"
+
+  size          = %d
+  node_template = "${google_compute_node_template.%s.self_link}"
+}
+`, tmplPrefix, tmplPrefix, groupName, size, tmplToUse)
+}
+
+func testAccComputeNodeGroup_instanceNodeAffinities(groupName, instanceName string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+  family  = "debian-9"
+  project = "debian-cloud"
+}
+
+resource "google_compute_node_template" "template" {
+  name      = "%s"
+  region    = "us-central1"
+  node_type = "n1-node-96-624"
+
+  node_affinity_labels = {
+    tfacc = "test"
+  }
+}
+
+resource "google_compute_node_group" "nodes" {
+  name          = "%s"
+  zone          = "us-central1-a"
+  size          = 1
+  node_template = "${google_compute_node_template.template.self_link}"
+}
+
+resource "google_compute_instance" "foobar" {
+  name         = "%s"
+  machine_type = "n1-standard-8"
+  zone         = "us-central1-a"
+
+  boot_disk {
+    initialize_params {
+      image = "${data.google_compute_image.my_image.self_link}"
+    }
+  }
+
+  network_interface {
+    network = "default"
+  }
+
+  scheduling {
+    node_affinities {
+      key      = "compute.googleapis.com/node-group-name"
+      operator = "IN"
+      values   = ["${google_compute_node_group.nodes.name}"]
+    }
+
+    node_affinities {
+      key      = "tfacc"
+      operator = "NOT_IN"
+      values   = ["prod"]
+    }
+  }
+}
+`, groupName, groupName, instanceName)
+}
@@ -0,0 +1,257 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v0.beta"
+)
+
+func resourceComputeNodeTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeNodeTemplateCreate,
+		Read:   resourceComputeNodeTemplateRead,
+		Delete: resourceComputeNodeTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeNodeTemplateImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateGCPName,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"node_affinity_labels": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"node_type": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"node_type_flexibility"},
+			},
+
+			"node_type_flexibility": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"node_type"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpus": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"memory": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"local_ssd": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"region": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"creation_timestamp": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"self_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeNodeTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	nodeTemplate := &compute.NodeTemplate{
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		NodeAffinityLabels:  expandStringMap(d, "node_affinity_labels"),
+		NodeType:            d.Get("node_type").(string),
+		NodeTypeFlexibility: expandNodeTemplateNodeTypeFlexibility(d.Get("node_type_flexibility").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] NodeTemplate insert request: %#v", nodeTemplate)
+	op, err := config.clientComputeBeta.NodeTemplates.Insert(project, region, nodeTemplate).Do()
+	if err != nil {
+		return errwrap.Wrapf("Error creating NodeTemplate: {{err}}", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", project, region, nodeTemplate.Name))
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Creating NodeTemplate %q", nodeTemplate.Name))
+	if err != nil {
+		return err
+	}
+
+	return resourceComputeNodeTemplateRead(d, meta)
+}
+
+func resourceComputeNodeTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseNodeTemplateId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	nodeTemplate, err := config.clientComputeBeta.NodeTemplates.Get(id.Project, id.Region, id.Name).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("NodeTemplate %q", id.Name))
+	}
+
+	d.Set("name", nodeTemplate.Name)
+	d.Set("description", nodeTemplate.Description)
+	d.Set("node_affinity_labels", nodeTemplate.NodeAffinityLabels)
+	d.Set("node_type", nodeTemplate.NodeType)
+	if err := d.Set("node_type_flexibility", flattenNodeTemplateNodeTypeFlexibility(nodeTemplate.NodeTypeFlexibility)); err != nil {
+		return fmt.Errorf("Error setting node_type_flexibility: %s", err)
+	}
+	d.Set("region", GetResourceNameFromSelfLink(nodeTemplate.Region))
+	d.Set("project", id.Project)
+	d.Set("creation_timestamp", nodeTemplate.CreationTimestamp)
+	d.Set("self_link", ConvertSelfLinkToV1(nodeTemplate.SelfLink))
+
+	return nil
+}
+
+func resourceComputeNodeTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseNodeTemplateId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	op, err := config.clientComputeBeta.NodeTemplates.Delete(id.Project, id.Region, id.Name).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("NodeTemplate %q", id.Name))
+	}
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, id.Project, int(d.Timeout(schema.TimeoutDelete).Minutes()), fmt.Sprintf("Deleting NodeTemplate %q", id.Name))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceComputeNodeTemplateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/nodeTemplates/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)", "(?P<region>[^/]+)/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	id, err := replaceVars(d, config, "{{project}}/{{region}}/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandNodeTemplateNodeTypeFlexibility(configured []interface{}) *compute.NodeTemplateNodeTypeFlexibility {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+	return &compute.NodeTemplateNodeTypeFlexibility{
+		Cpus:   data["cpus"].(string),
+		Memory: data["memory"].(string),
+	}
+}
+
+func flattenNodeTemplateNodeTypeFlexibility(flexibility *compute.NodeTemplateNodeTypeFlexibility) []map[string]interface{} {
+	if flexibility == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"cpus":      flexibility.Cpus,
+			"memory":    flexibility.Memory,
+			"local_ssd": flexibility.LocalSsd,
+		},
+	}
+}
+
+type nodeTemplateId struct {
+	Project string
+	Region  string
+	Name    string
+}
+
+func parseNodeTemplateId(id string) (*nodeTemplateId, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid google_compute_node_template id format, expecting `{project}/{region}/{name}`, found %s", id)
+	}
+
+	return &nodeTemplateId{
+		Project: parts[0],
+		Region:  parts[1],
+		Name:    parts[2],
+	}, nil
+}
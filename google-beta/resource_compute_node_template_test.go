@@ -0,0 +1,105 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeNodeTemplate_basic(t *testing.T) {
+	t.Parallel()
+
+	templateName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNodeTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeNodeTemplate_basic(templateName),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_node_template.template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeNodeTemplate_nodeTypeFlexibility(t *testing.T) {
+	t.Parallel()
+
+	templateName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNodeTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeNodeTemplate_nodeTypeFlexibility(templateName),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_node_template.template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeNodeTemplateDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_node_template" {
+			continue
+		}
+
+		id, err := parseNodeTemplateId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = config.clientComputeBeta.NodeTemplates.Get(id.Project, id.Region, id.Name).Do()
+		if err == nil {
+			return fmt.Errorf("NodeTemplate %q still exists", id.Name)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeNodeTemplate_basic(templateName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_node_template" "template" {
+  name        = "%s"
+  region      = "us-central1"
+  description = "sole-tenant node template"
+  node_type   = "n1-node-96-624"
+
+  node_affinity_labels = {
+    foo = "baz"
+  }
+}
+`, templateName)
+}
+
+func testAccComputeNodeTemplate_nodeTypeFlexibility(templateName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_node_template" "template" {
+  name   = "%s"
+  region = "us-central1"
+
+  node_type_flexibility {
+    cpus   = "96"
+    memory = "any"
+  }
+}
+`, templateName)
+}
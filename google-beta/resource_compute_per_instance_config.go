@@ -0,0 +1,493 @@
+package google
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/compute/v1"
+)
+
+// Per-instance configs aren't in the vendored compute client, so this resource
+// calls the beta API's instanceGroupManagers methods directly.
+
+func resourceComputePerInstanceConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputePerInstanceConfigCreate,
+		Read:   resourceComputePerInstanceConfigRead,
+		Update: resourceComputePerInstanceConfigUpdate,
+		Delete: resourceComputePerInstanceConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputePerInstanceConfigImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_group_manager": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateGCPName,
+			},
+
+			"preserved_state": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metadata": &schema.Schema{
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"disk": &schema.Schema{
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      perInstanceConfigDiskHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"device_name": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"source": &schema.Schema{
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: compareSelfLinkRelativePaths,
+									},
+
+									"mode": &schema.Schema{
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "READ_WRITE",
+										ValidateFunc: validation.StringInSlice([]string{"READ_ONLY", "READ_WRITE"}, false),
+									},
+
+									"delete_rule": &schema.Schema{
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "NEVER",
+										ValidateFunc: validation.StringInSlice([]string{"NEVER", "ON_PERMANENT_INSTANCE_DELETION"}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"minimal_action": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "REFRESH", "RESTART", "REPLACE"}, false),
+			},
+
+			"most_disruptive_allowed_action": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "REPLACE",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "REFRESH", "RESTART", "REPLACE"}, false),
+			},
+
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputePerInstanceConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	igm := GetResourceNameFromSelfLink(d.Get("instance_group_manager").(string))
+	lockName := perInstanceConfigLockName(project, zone, igm)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	name := d.Get("name").(string)
+	perInstanceConfig := map[string]interface{}{
+		"name":           name,
+		"preservedState": expandPerInstanceConfigPreservedState(nil, d.Get("preserved_state").([]interface{})),
+	}
+
+	timeout := int(d.Timeout(schema.TimeoutCreate).Minutes())
+	err = updatePerInstanceConfig(config, project, zone, igm, perInstanceConfig, timeout)
+	if err != nil {
+		return fmt.Errorf("Error creating PerInstanceConfig: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", project, zone, igm, name))
+
+	err = applyPerInstanceConfig(d, config, project, zone, igm, timeout)
+	if err != nil {
+		return err
+	}
+
+	return resourceComputePerInstanceConfigRead(d, meta)
+}
+
+func resourceComputePerInstanceConfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	igm := GetResourceNameFromSelfLink(d.Get("instance_group_manager").(string))
+	name := d.Get("name").(string)
+
+	perInstanceConfig, err := findPerInstanceConfig(config, project, zone, igm, name)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("PerInstanceConfig %q", d.Id()))
+	}
+	if perInstanceConfig == nil {
+		log.Printf("[WARN] Removing PerInstanceConfig %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("preserved_state", flattenPerInstanceConfigPreservedState(perInstanceConfig["preservedState"])); err != nil {
+		return fmt.Errorf("Error setting preserved_state: %s", err)
+	}
+	d.Set("project", project)
+	d.Set("zone", zone)
+
+	return nil
+}
+
+func resourceComputePerInstanceConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	igm := GetResourceNameFromSelfLink(d.Get("instance_group_manager").(string))
+	lockName := perInstanceConfigLockName(project, zone, igm)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	timeout := int(d.Timeout(schema.TimeoutUpdate).Minutes())
+	if d.HasChange("preserved_state") {
+		o, n := d.GetChange("preserved_state")
+		perInstanceConfig := map[string]interface{}{
+			"name":           d.Get("name").(string),
+			"preservedState": expandPerInstanceConfigPreservedState(o.([]interface{}), n.([]interface{})),
+		}
+
+		err = updatePerInstanceConfig(config, project, zone, igm, perInstanceConfig, timeout)
+		if err != nil {
+			return fmt.Errorf("Error updating PerInstanceConfig %q: %s", d.Id(), err)
+		}
+
+		err = applyPerInstanceConfig(d, config, project, zone, igm, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceComputePerInstanceConfigRead(d, meta)
+}
+
+func resourceComputePerInstanceConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	igm := GetResourceNameFromSelfLink(d.Get("instance_group_manager").(string))
+	lockName := perInstanceConfigLockName(project, zone, igm)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	obj := map[string]interface{}{
+		"names": []string{d.Get("name").(string)},
+	}
+
+	log.Printf("[DEBUG] Deleting PerInstanceConfig %q", d.Id())
+	res, err := sendRequest(config, "POST", perInstanceConfigManagerUrl(project, zone, igm)+"/deletePerInstanceConfigs", obj)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("PerInstanceConfig %q", d.Id()))
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(config.clientCompute, op, project, "Deleting PerInstanceConfig", int(d.Timeout(schema.TimeoutDelete).Minutes()))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceComputePerInstanceConfigImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instanceGroupManagers/(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)",
+		"(?P<zone>[^/]+)/(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)",
+		"(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	id, err := replaceVars(d, config, "{{project}}/{{zone}}/{{instance_group_manager}}/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// These only control how changes are applied and can't be read back.
+	d.Set("minimal_action", "NONE")
+	d.Set("most_disruptive_allowed_action", "REPLACE")
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func perInstanceConfigManagerUrl(project, zone, igm string) string {
+	return fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/zones/%s/instanceGroupManagers/%s", project, zone, igm)
+}
+
+// Changes to a manager's per-instance configs are checked against its
+// fingerprint, so concurrent changes to the same manager would fail.
+func perInstanceConfigLockName(project, zone, igm string) string {
+	return fmt.Sprintf("instanceGroupManager/%s/%s/%s", project, zone, igm)
+}
+
+// updatePerInstanceConfig inserts a per-instance config, or patches it if one
+// with the same name already exists.
+func updatePerInstanceConfig(config *Config, project, zone, igm string, perInstanceConfig map[string]interface{}, timeout int) error {
+	obj := map[string]interface{}{
+		"perInstanceConfigs": []interface{}{perInstanceConfig},
+	}
+
+	log.Printf("[DEBUG] Updating per-instance configs of %q: %#v", igm, obj)
+	res, err := sendRequest(config, "POST", perInstanceConfigManagerUrl(project, zone, igm)+"/updatePerInstanceConfigs", obj)
+	if err != nil {
+		return err
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	return computeOperationWaitTime(config.clientCompute, op, project, "Updating PerInstanceConfig", timeout)
+}
+
+// applyPerInstanceConfig updates the instance so it picks up its config, rather
+// than waiting for it to be recreated or updated by the manager.
+func applyPerInstanceConfig(d *schema.ResourceData, config *Config, project, zone, igm string, timeout int) error {
+	obj := map[string]interface{}{
+		"instances":                   []string{fmt.Sprintf("zones/%s/instances/%s", zone, d.Get("name").(string))},
+		"minimalAction":               d.Get("minimal_action").(string),
+		"mostDisruptiveAllowedAction": d.Get("most_disruptive_allowed_action").(string),
+	}
+
+	log.Printf("[DEBUG] Applying PerInstanceConfig %q: %#v", d.Id(), obj)
+	res, err := sendRequest(config, "POST", perInstanceConfigManagerUrl(project, zone, igm)+"/applyUpdatesToInstances", obj)
+	if err != nil {
+		return fmt.Errorf("Error applying PerInstanceConfig %q: %s", d.Id(), err)
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	return computeOperationWaitTime(config.clientCompute, op, project, "Applying PerInstanceConfig", timeout)
+}
+
+// findPerInstanceConfig returns the per-instance config with the given name, or
+// nil if the manager has none.
+func findPerInstanceConfig(config *Config, project, zone, igm, name string) (map[string]interface{}, error) {
+	url := perInstanceConfigManagerUrl(project, zone, igm) + "/listPerInstanceConfigs"
+
+	pageToken := ""
+	for {
+		pageUrl := url
+		if pageToken != "" {
+			pageUrl = fmt.Sprintf("%s?pageToken=%s", url, pageToken)
+		}
+
+		res, err := sendRequest(config, "POST", pageUrl, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		items, _ := res["items"].([]interface{})
+		for _, raw := range items {
+			item, ok := raw.(map[string]interface{})
+			if ok && item["name"] == name {
+				return item, nil
+			}
+		}
+
+		pageToken, _ = res["nextPageToken"].(string)
+		if pageToken == "" {
+			return nil, nil
+		}
+	}
+}
+
+// expandPerInstanceConfigPreservedState returns the preservedState of an
+// updatePerInstanceConfigs request. Existing configs are patched, which merges
+// maps, so metadata and disks no longer in the config are sent as null.
+func expandPerInstanceConfigPreservedState(old, new []interface{}) map[string]interface{} {
+	metadata := make(map[string]interface{})
+	disks := make(map[string]interface{})
+
+	if len(old) > 0 && old[0] != nil {
+		data := old[0].(map[string]interface{})
+		for k := range data["metadata"].(map[string]interface{}) {
+			metadata[k] = nil
+		}
+		for _, raw := range data["disk"].(*schema.Set).List() {
+			disks[raw.(map[string]interface{})["device_name"].(string)] = nil
+		}
+	}
+
+	if len(new) > 0 && new[0] != nil {
+		data := new[0].(map[string]interface{})
+		for k, v := range data["metadata"].(map[string]interface{}) {
+			metadata[k] = v.(string)
+		}
+		for _, raw := range data["disk"].(*schema.Set).List() {
+			disk := raw.(map[string]interface{})
+			disks[disk["device_name"].(string)] = map[string]interface{}{
+				"source":     disk["source"].(string),
+				"mode":       disk["mode"].(string),
+				"autoDelete": disk["delete_rule"].(string),
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"metadata": metadata,
+		"disks":    disks,
+	}
+}
+
+func flattenPerInstanceConfigPreservedState(v interface{}) []map[string]interface{} {
+	preservedState, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	metadata, _ := preservedState["metadata"].(map[string]interface{})
+
+	disks := schema.NewSet(perInstanceConfigDiskHash, []interface{}{})
+	if rawDisks, ok := preservedState["disks"].(map[string]interface{}); ok {
+		for deviceName, raw := range rawDisks {
+			disk, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			source, _ := disk["source"].(string)
+			mode, _ := disk["mode"].(string)
+			if mode == "" {
+				mode = "READ_WRITE"
+			}
+			deleteRule, _ := disk["autoDelete"].(string)
+			if deleteRule == "" {
+				deleteRule = "NEVER"
+			}
+			disks.Add(map[string]interface{}{
+				"device_name": deviceName,
+				"source":      ConvertSelfLinkToV1(source),
+				"mode":        mode,
+				"delete_rule": deleteRule,
+			})
+		}
+	}
+
+	if len(metadata) == 0 && disks.Len() == 0 {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"metadata": metadata,
+			"disk":     disks,
+		},
+	}
+}
+
+// perInstanceConfigDiskHash hashes disks by the disk's relative path, so a
+// source given as a partial URL matches the full URL the API returns.
+func perInstanceConfigDiskHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["device_name"].(string)))
+	if source, err := getRelativePath(m["source"].(string)); err == nil {
+		buf.WriteString(fmt.Sprintf("%s-", source))
+	} else {
+		buf.WriteString(fmt.Sprintf("%s-", m["source"].(string)))
+	}
+	buf.WriteString(fmt.Sprintf("%s-", m["mode"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["delete_rule"].(string)))
+	return hashcode.String(buf.String())
+}
@@ -0,0 +1,189 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandPerInstanceConfigPreservedState(t *testing.T) {
+	disk := func(deviceName, source string) map[string]interface{} {
+		return map[string]interface{}{
+			"device_name": deviceName,
+			"source":      source,
+			"mode":        "READ_WRITE",
+			"delete_rule": "NEVER",
+		}
+	}
+	state := func(metadata map[string]interface{}, disks ...interface{}) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"metadata": metadata,
+				"disk":     schema.NewSet(perInstanceConfigDiskHash, disks),
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		Old, New []interface{}
+		Expected map[string]interface{}
+	}{
+		"create": {
+			New: state(map[string]interface{}{"role": "broker"}, disk("data", "projects/p/zones/z/disks/d")),
+			Expected: map[string]interface{}{
+				"metadata": map[string]interface{}{"role": "broker"},
+				"disks": map[string]interface{}{
+					"data": map[string]interface{}{
+						"source":     "projects/p/zones/z/disks/d",
+						"mode":       "READ_WRITE",
+						"autoDelete": "NEVER",
+					},
+				},
+			},
+		},
+		"removed entries are nulled": {
+			Old: state(map[string]interface{}{"role": "broker", "rack": "a"}, disk("data", "projects/p/zones/z/disks/d"), disk("logs", "projects/p/zones/z/disks/l")),
+			New: state(map[string]interface{}{"role": "zookeeper"}, disk("data", "projects/p/zones/z/disks/d")),
+			Expected: map[string]interface{}{
+				"metadata": map[string]interface{}{"role": "zookeeper", "rack": nil},
+				"disks": map[string]interface{}{
+					"data": map[string]interface{}{
+						"source":     "projects/p/zones/z/disks/d",
+						"mode":       "READ_WRITE",
+						"autoDelete": "NEVER",
+					},
+					"logs": nil,
+				},
+			},
+		},
+		"block removed": {
+			Old: state(map[string]interface{}{"role": "broker"}),
+			New: []interface{}{},
+			Expected: map[string]interface{}{
+				"metadata": map[string]interface{}{"role": nil},
+				"disks":    map[string]interface{}{},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		got := expandPerInstanceConfigPreservedState(tc.Old, tc.New)
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("bad: %s, expected %#v, got %#v", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestAccComputePerInstanceConfig_update(t *testing.T) {
+	t.Parallel()
+
+	template := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	igm := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	disk := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	instance := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputePerInstanceConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputePerInstanceConfig_basic(template, igm, disk, instance, "broker"),
+			},
+			{
+				ResourceName:      "google_compute_per_instance_config.config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputePerInstanceConfig_basic(template, igm, disk, instance, "zookeeper"),
+			},
+			{
+				ResourceName:      "google_compute_per_instance_config.config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputePerInstanceConfigDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_per_instance_config" {
+			continue
+		}
+
+		attrs := rs.Primary.Attributes
+		perInstanceConfig, err := findPerInstanceConfig(config, attrs["project"], attrs["zone"], attrs["instance_group_manager"], attrs["name"])
+		if err == nil && perInstanceConfig != nil {
+			return fmt.Errorf("PerInstanceConfig %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputePerInstanceConfig_basic(template, igm, disk, instance, role string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+  family  = "debian-9"
+  project = "debian-cloud"
+}
+
+resource "google_compute_instance_template" "template" {
+  name         = "%s"
+  machine_type = "n1-standard-1"
+
+  disk {
+    source_image = "${data.google_compute_image.my_image.self_link}"
+    auto_delete  = true
+    boot         = true
+  }
+
+  network_interface {
+    network = "default"
+  }
+}
+
+resource "google_compute_instance_group_manager" "igm" {
+  name               = "%s"
+  base_instance_name = "igm"
+  zone               = "us-central1-c"
+
+  version {
+    name              = "prod"
+    instance_template = "${google_compute_instance_template.template.self_link}"
+  }
+}
+
+resource "google_compute_disk" "data" {
+  name = "%s"
+  zone = "us-central1-c"
+  size = 10
+}
+
+resource "google_compute_per_instance_config" "config" {
+  zone                   = "us-central1-c"
+  instance_group_manager = "${google_compute_instance_group_manager.igm.name}"
+  name                   = "%s"
+
+  preserved_state {
+    metadata = {
+      role = "%s"
+    }
+
+    disk {
+      device_name = "data"
+      source      = "${google_compute_disk.data.self_link}"
+    }
+  }
+}
+`, template, igm, disk, instance, role)
+}
@@ -140,6 +140,16 @@ func resourceComputeRegionDisk() *schema.Resource {
 					},
 				},
 			},
+			"resource_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					DiffSuppressFunc: compareSelfLinkOrResourceName,
+				},
+			},
 			"type": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -259,6 +269,12 @@ func resourceComputeRegionDiskCreate(d *schema.ResourceData, meta interface{}) e
 	} else if v, ok := d.GetOkExists("source_snapshot_encryption_key"); !isEmptyValue(reflect.ValueOf(sourceSnapshotEncryptionKeyProp)) && (ok || !reflect.DeepEqual(v, sourceSnapshotEncryptionKeyProp)) {
 		obj["sourceSnapshotEncryptionKey"] = sourceSnapshotEncryptionKeyProp
 	}
+	resourcePoliciesProp, err := expandComputeRegionDiskResourcePolicies(d.Get("resource_policies"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("resource_policies"); !isEmptyValue(reflect.ValueOf(resourcePoliciesProp)) && (ok || !reflect.DeepEqual(v, resourcePoliciesProp)) {
+		obj["resourcePolicies"] = resourcePoliciesProp
+	}
 
 	obj, err = resourceComputeRegionDiskEncoder(d, meta, obj)
 	if err != nil {
@@ -364,6 +380,9 @@ func resourceComputeRegionDiskRead(d *schema.ResourceData, meta interface{}) err
 	if err := d.Set("replica_zones", flattenComputeRegionDiskReplicaZones(res["replicaZones"], d)); err != nil {
 		return fmt.Errorf("Error reading RegionDisk: %s", err)
 	}
+	if err := d.Set("resource_policies", flattenComputeRegionDiskResourcePolicies(res["resourcePolicies"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionDisk: %s", err)
+	}
 	if err := d.Set("type", flattenComputeRegionDiskType(res["type"], d)); err != nil {
 		return fmt.Errorf("Error reading RegionDisk: %s", err)
 	}
@@ -635,6 +654,13 @@ func flattenComputeRegionDiskReplicaZones(v interface{}, d *schema.ResourceData)
 	return convertAndMapStringArr(v.([]interface{}), ConvertSelfLinkToV1)
 }
 
+func flattenComputeRegionDiskResourcePolicies(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return convertAndMapStringArr(v.([]interface{}), ConvertSelfLinkToV1)
+}
+
 func flattenComputeRegionDiskType(v interface{}, d *schema.ResourceData) interface{} {
 	if v == nil {
 		return v
@@ -758,6 +784,19 @@ func expandComputeRegionDiskReplicaZones(v interface{}, d *schema.ResourceData,
 	return req, nil
 }
 
+func expandComputeRegionDiskResourcePolicies(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		f, err := parseRegionalFieldValue("resourcePolicies", raw.(string), "project", "region", "zone", d, config, true)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for resource_policies: %s", err)
+		}
+		req = append(req, f.RelativeLink())
+	}
+	return req, nil
+}
+
 func expandComputeRegionDiskType(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
 	f, err := parseRegionalFieldValue("diskTypes", v.(string), "project", "region", "zone", d, config, true)
 	if err != nil {
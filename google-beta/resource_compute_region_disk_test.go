@@ -175,6 +175,35 @@ func TestAccComputeRegionDisk_deleteDetach(t *testing.T) {
 	})
 }
 
+func TestAccComputeRegionDisk_resourcePolicies(t *testing.T) {
+	t.Parallel()
+
+	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	policyName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	var disk computeBeta.Disk
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionDiskDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeRegionDisk_resourcePolicies(diskName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeRegionDiskExists(
+						"google_compute_region_disk.regiondisk", &disk),
+					testAccCheckComputeRegionDiskHasResourcePolicy(&disk, policyName),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_region_disk.regiondisk",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeRegionDiskExists(n string, disk *computeBeta.Disk) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		p := getTestProjectFromEnv()
@@ -236,6 +265,18 @@ func testAccCheckComputeRegionDiskHasLabelFingerprint(disk *computeBeta.Disk, re
 	}
 }
 
+func testAccCheckComputeRegionDiskHasResourcePolicy(disk *computeBeta.Disk, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, policy := range disk.ResourcePolicies {
+			if GetResourceNameFromSelfLink(policy) == policyName {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Resource policy %q not found on disk, got %v", policyName, disk.ResourcePolicies)
+	}
+}
+
 func testAccCheckRegionDiskEncryptionKey(n string, disk *computeBeta.Disk) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -411,3 +452,17 @@ resource "google_compute_instance" "inst" {
 	}
 }`, diskName, diskName, regionDiskName, instanceName)
 }
+
+func testAccComputeRegionDisk_resourcePolicies(diskName, policyName string) string {
+	return testAccComputeDiskResourcePolicy_policy(policyName) + fmt.Sprintf(`
+resource "google_compute_region_disk" "regiondisk" {
+	name   = "%s"
+	type   = "pd-ssd"
+	size   = 200
+	region = "us-central1"
+
+	replica_zones = ["us-central1-a", "us-central1-f"]
+
+	resource_policies = ["${google_compute_resource_policy.policy.self_link}"]
+}`, diskName)
+}
@@ -34,6 +34,7 @@ func resourceComputeRegionInstanceGroupManager() *schema.Resource {
 			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(15 * time.Minute),
 		},
+		CustomizeDiff: computeQuotaCheckCustomizeDiff(regionInstanceGroupManagerQuotaDemand),
 
 		Schema: map[string]*schema.Schema{
 			"base_instance_name": &schema.Schema{
@@ -250,6 +251,26 @@ func resourceComputeRegionInstanceGroupManager() *schema.Resource {
 					},
 				},
 			},
+
+			"stateful_disk": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"delete_rule": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "NEVER",
+							ValidateFunc: validation.StringInSlice([]string{"NEVER", "ON_PERMANENT_INSTANCE_DELETION"}, false),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -295,6 +316,14 @@ func resourceComputeRegionInstanceGroupManagerCreate(d *schema.ResourceData, met
 	if err != nil {
 		return err
 	}
+
+	if d.Get("stateful_disk").(*schema.Set).Len() > 0 {
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/regions/%s/instanceGroupManagers/%s", project, region, manager.Name)
+		if err := patchInstanceGroupManagerStatefulPolicy(config, d, url, project, int(d.Timeout(schema.TimeoutCreate).Minutes())); err != nil {
+			return err
+		}
+	}
+
 	return resourceComputeRegionInstanceGroupManagerRead(d, config)
 }
 
@@ -396,6 +425,13 @@ func resourceComputeRegionInstanceGroupManagerRead(d *schema.ResourceData, meta
 	if err := d.Set("update_policy", flattenUpdatePolicy(manager.UpdatePolicy)); err != nil {
 		return fmt.Errorf("Error setting update_policy in state: %s", err.Error())
 	}
+	statefulDisks, err := readInstanceGroupManagerStatefulDisks(config, manager.SelfLink)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("stateful_disk", statefulDisks); err != nil {
+		return fmt.Errorf("Error setting stateful_disk in state: %s", err.Error())
+	}
 
 	if d.Get("wait_for_instances").(bool) {
 		conf := resource.StateChangeConf{
@@ -464,6 +500,13 @@ func resourceComputeRegionInstanceGroupManagerUpdate(d *schema.ResourceData, met
 		}
 	}
 
+	if d.HasChange("stateful_disk") {
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/regions/%s/instanceGroupManagers/%s", project, region, d.Get("name").(string))
+		if err := patchInstanceGroupManagerStatefulPolicy(config, d, url, project, int(d.Timeout(schema.TimeoutUpdate).Minutes())); err != nil {
+			return err
+		}
+	}
+
 	// named ports can't be updated through PATCH
 	// so we call the update method on the region instance group, instead of the rigm
 	if d.HasChange("named_port") {
@@ -10,6 +10,13 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_region_instance_group_manager", testSweeper{
+		List:   listRegionalComputeResources("instanceGroupManagers"),
+		Delete: sweepComputeResource,
+	})
+}
+
 func TestAccRegionInstanceGroupManager_basic(t *testing.T) {
 	t.Parallel()
 
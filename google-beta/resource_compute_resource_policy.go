@@ -0,0 +1,516 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/compute/v1"
+)
+
+// Snapshot schedules aren't in the vendored compute client, so resource
+// policies are managed with requests to the beta API.
+
+func resourceComputeResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeResourcePolicyCreate,
+		Read:   resourceComputeResourcePolicyRead,
+		Delete: resourceComputeResourcePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeResourcePolicyImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateGCPName,
+			},
+
+			"snapshot_schedule_policy": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schedule": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hourly_schedule": &schema.Schema{
+										Type:          schema.TypeList,
+										Optional:      true,
+										ForceNew:      true,
+										MaxItems:      1,
+										ConflictsWith: []string{"snapshot_schedule_policy.0.schedule.0.daily_schedule", "snapshot_schedule_policy.0.schedule.0.weekly_schedule"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"hours_in_cycle": &schema.Schema{
+													Type:     schema.TypeInt,
+													Required: true,
+													ForceNew: true,
+												},
+
+												"start_time": &schema.Schema{
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validateRFC3339Time,
+												},
+											},
+										},
+									},
+
+									"daily_schedule": &schema.Schema{
+										Type:          schema.TypeList,
+										Optional:      true,
+										ForceNew:      true,
+										MaxItems:      1,
+										ConflictsWith: []string{"snapshot_schedule_policy.0.schedule.0.hourly_schedule", "snapshot_schedule_policy.0.schedule.0.weekly_schedule"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"days_in_cycle": &schema.Schema{
+													Type:     schema.TypeInt,
+													Required: true,
+													ForceNew: true,
+												},
+
+												"start_time": &schema.Schema{
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validateRFC3339Time,
+												},
+											},
+										},
+									},
+
+									"weekly_schedule": &schema.Schema{
+										Type:          schema.TypeList,
+										Optional:      true,
+										ForceNew:      true,
+										MaxItems:      1,
+										ConflictsWith: []string{"snapshot_schedule_policy.0.schedule.0.hourly_schedule", "snapshot_schedule_policy.0.schedule.0.daily_schedule"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"day_of_weeks": &schema.Schema{
+													Type:     schema.TypeSet,
+													Required: true,
+													ForceNew: true,
+													MinItems: 1,
+													MaxItems: 7,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"day": &schema.Schema{
+																Type:         schema.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringInSlice([]string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"}, false),
+															},
+
+															"start_time": &schema.Schema{
+																Type:         schema.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: validateRFC3339Time,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"retention_policy": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_retention_days": &schema.Schema{
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+
+									"on_source_disk_delete": &schema.Schema{
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      "KEEP_AUTO_SNAPSHOTS",
+										ValidateFunc: validation.StringInSlice([]string{"KEEP_AUTO_SNAPSHOTS", "APPLY_RETENTION_POLICY"}, false),
+									},
+								},
+							},
+						},
+
+						"snapshot_properties": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"labels": &schema.Schema{
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"storage_locations": &schema.Schema{
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+									},
+
+									"guest_flush": &schema.Schema{
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"region": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"self_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeResourcePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	if v := expandComputeResourcePolicySnapshotSchedulePolicy(d.Get("snapshot_schedule_policy").([]interface{})); v != nil {
+		obj["snapshotSchedulePolicy"] = v
+	}
+
+	url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/regions/%s/resourcePolicies", project, region)
+
+	log.Printf("[DEBUG] Creating new ResourcePolicy: %#v", obj)
+	res, err := sendRequest(config, "POST", url, obj)
+	if err != nil {
+		return fmt.Errorf("Error creating ResourcePolicy: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", project, region, d.Get("name").(string)))
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(config.clientCompute, op, project, "Creating ResourcePolicy", int(d.Timeout(schema.TimeoutCreate).Minutes()))
+	if err != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create ResourcePolicy: %s", err)
+	}
+
+	return resourceComputeResourcePolicyRead(d, meta)
+}
+
+func resourceComputeResourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseResourcePolicyId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", resourcePolicyUrl(id), nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ResourcePolicy %q", id.Name))
+	}
+
+	d.Set("name", res["name"])
+	if err := d.Set("snapshot_schedule_policy", flattenComputeResourcePolicySnapshotSchedulePolicy(res["snapshotSchedulePolicy"])); err != nil {
+		return fmt.Errorf("Error reading ResourcePolicy: %s", err)
+	}
+	d.Set("region", id.Region)
+	d.Set("project", id.Project)
+	if selfLink, ok := res["selfLink"].(string); ok {
+		d.Set("self_link", ConvertSelfLinkToV1(selfLink))
+	}
+
+	return nil
+}
+
+func resourceComputeResourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := parseResourcePolicyId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting ResourcePolicy %q", d.Id())
+	res, err := sendRequest(config, "DELETE", resourcePolicyUrl(id), nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ResourcePolicy %q", id.Name))
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(config.clientCompute, op, id.Project, "Deleting ResourcePolicy", int(d.Timeout(schema.TimeoutDelete).Minutes()))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceComputeResourcePolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/resourcePolicies/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)", "(?P<region>[^/]+)/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	id, err := replaceVars(d, config, "{{project}}/{{region}}/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandComputeResourcePolicySnapshotSchedulePolicy(configured []interface{}) map[string]interface{} {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+	data := configured[0].(map[string]interface{})
+
+	policy := map[string]interface{}{
+		"schedule": expandComputeResourcePolicySchedule(data["schedule"].([]interface{})),
+	}
+
+	if l := data["retention_policy"].([]interface{}); len(l) > 0 && l[0] != nil {
+		retention := l[0].(map[string]interface{})
+		policy["retentionPolicy"] = map[string]interface{}{
+			"maxRetentionDays":   retention["max_retention_days"].(int),
+			"onSourceDiskDelete": retention["on_source_disk_delete"].(string),
+		}
+	}
+
+	if l := data["snapshot_properties"].([]interface{}); len(l) > 0 && l[0] != nil {
+		properties := l[0].(map[string]interface{})
+		policy["snapshotProperties"] = map[string]interface{}{
+			"labels":           properties["labels"],
+			"storageLocations": convertStringSet(properties["storage_locations"].(*schema.Set)),
+			"guestFlush":       properties["guest_flush"].(bool),
+		}
+	}
+
+	return policy
+}
+
+func expandComputeResourcePolicySchedule(configured []interface{}) map[string]interface{} {
+	schedule := make(map[string]interface{})
+	if len(configured) == 0 || configured[0] == nil {
+		return schedule
+	}
+	data := configured[0].(map[string]interface{})
+
+	if l := data["hourly_schedule"].([]interface{}); len(l) > 0 && l[0] != nil {
+		hourly := l[0].(map[string]interface{})
+		schedule["hourlySchedule"] = map[string]interface{}{
+			"hoursInCycle": hourly["hours_in_cycle"].(int),
+			"startTime":    hourly["start_time"].(string),
+		}
+	}
+
+	if l := data["daily_schedule"].([]interface{}); len(l) > 0 && l[0] != nil {
+		daily := l[0].(map[string]interface{})
+		schedule["dailySchedule"] = map[string]interface{}{
+			"daysInCycle": daily["days_in_cycle"].(int),
+			"startTime":   daily["start_time"].(string),
+		}
+	}
+
+	if l := data["weekly_schedule"].([]interface{}); len(l) > 0 && l[0] != nil {
+		weekly := l[0].(map[string]interface{})
+		days := make([]interface{}, 0)
+		for _, raw := range weekly["day_of_weeks"].(*schema.Set).List() {
+			day := raw.(map[string]interface{})
+			days = append(days, map[string]interface{}{
+				"day":       day["day"].(string),
+				"startTime": day["start_time"].(string),
+			})
+		}
+		schedule["weeklySchedule"] = map[string]interface{}{
+			"dayOfWeeks": days,
+		}
+	}
+
+	return schedule
+}
+
+func flattenComputeResourcePolicySnapshotSchedulePolicy(v interface{}) []map[string]interface{} {
+	policy, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"schedule": flattenComputeResourcePolicySchedule(policy["schedule"]),
+	}
+
+	if retention, ok := policy["retentionPolicy"].(map[string]interface{}); ok {
+		result["retention_policy"] = []map[string]interface{}{
+			{
+				"max_retention_days":    retention["maxRetentionDays"],
+				"on_source_disk_delete": retention["onSourceDiskDelete"],
+			},
+		}
+	}
+
+	if properties, ok := policy["snapshotProperties"].(map[string]interface{}); ok {
+		storageLocations := schema.NewSet(schema.HashString, nil)
+		if l, ok := properties["storageLocations"].([]interface{}); ok {
+			for _, location := range l {
+				storageLocations.Add(location)
+			}
+		}
+		result["snapshot_properties"] = []map[string]interface{}{
+			{
+				"labels":            properties["labels"],
+				"storage_locations": storageLocations,
+				"guest_flush":       properties["guestFlush"],
+			},
+		}
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func flattenComputeResourcePolicySchedule(v interface{}) []map[string]interface{} {
+	schedule, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := make(map[string]interface{})
+
+	if hourly, ok := schedule["hourlySchedule"].(map[string]interface{}); ok {
+		result["hourly_schedule"] = []map[string]interface{}{
+			{
+				"hours_in_cycle": hourly["hoursInCycle"],
+				"start_time":     hourly["startTime"],
+			},
+		}
+	}
+
+	if daily, ok := schedule["dailySchedule"].(map[string]interface{}); ok {
+		result["daily_schedule"] = []map[string]interface{}{
+			{
+				"days_in_cycle": daily["daysInCycle"],
+				"start_time":    daily["startTime"],
+			},
+		}
+	}
+
+	if weekly, ok := schedule["weeklySchedule"].(map[string]interface{}); ok {
+		days := make([]interface{}, 0)
+		if l, ok := weekly["dayOfWeeks"].([]interface{}); ok {
+			for _, raw := range l {
+				day, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				days = append(days, map[string]interface{}{
+					"day":        day["day"],
+					"start_time": day["startTime"],
+				})
+			}
+		}
+		result["weekly_schedule"] = []map[string]interface{}{
+			{
+				"day_of_weeks": days,
+			},
+		}
+	}
+
+	return []map[string]interface{}{result}
+}
+
+type resourcePolicyId struct {
+	Project string
+	Region  string
+	Name    string
+}
+
+func parseResourcePolicyId(id string) (*resourcePolicyId, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid google_compute_resource_policy id format, expecting `{project}/{region}/{name}`, found %s", id)
+	}
+
+	return &resourcePolicyId{
+		Project: parts[0],
+		Region:  parts[1],
+		Name:    parts[2],
+	}, nil
+}
+
+func resourcePolicyUrl(id *resourcePolicyId) string {
+	return fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/regions/%s/resourcePolicies/%s", id.Project, id.Region, id.Name)
+}
@@ -0,0 +1,207 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandComputeResourcePolicySchedule(t *testing.T) {
+	cases := map[string]struct {
+		Schedule []interface{}
+		Expected map[string]interface{}
+	}{
+		"hourly": {
+			Schedule: []interface{}{
+				map[string]interface{}{
+					"hourly_schedule": []interface{}{
+						map[string]interface{}{
+							"hours_in_cycle": 4,
+							"start_time":     "03:00",
+						},
+					},
+					"daily_schedule":  []interface{}{},
+					"weekly_schedule": []interface{}{},
+				},
+			},
+			Expected: map[string]interface{}{
+				"hourlySchedule": map[string]interface{}{
+					"hoursInCycle": 4,
+					"startTime":    "03:00",
+				},
+			},
+		},
+		"weekly": {
+			Schedule: []interface{}{
+				map[string]interface{}{
+					"hourly_schedule": []interface{}{},
+					"daily_schedule":  []interface{}{},
+					"weekly_schedule": []interface{}{
+						map[string]interface{}{
+							"day_of_weeks": schema.NewSet(schema.HashResource(&schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day":        {Type: schema.TypeString},
+									"start_time": {Type: schema.TypeString},
+								},
+							}), []interface{}{
+								map[string]interface{}{
+									"day":        "SUNDAY",
+									"start_time": "23:00",
+								},
+							}),
+						},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"weeklySchedule": map[string]interface{}{
+					"dayOfWeeks": []interface{}{
+						map[string]interface{}{
+							"day":       "SUNDAY",
+							"startTime": "23:00",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		got := expandComputeResourcePolicySchedule(tc.Schedule)
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("bad: %s, expected %#v, got %#v", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestAccComputeResourcePolicy_dailySchedule(t *testing.T) {
+	t.Parallel()
+
+	policyName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeResourcePolicy_dailySchedule(policyName),
+			},
+			{
+				ResourceName:      "google_compute_resource_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeResourcePolicy_weeklySchedule(t *testing.T) {
+	t.Parallel()
+
+	policyName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeResourcePolicy_weeklySchedule(policyName),
+			},
+			{
+				ResourceName:      "google_compute_resource_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeResourcePolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_resource_policy" {
+			continue
+		}
+
+		id, err := parseResourcePolicyId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", resourcePolicyUrl(id), nil)
+		if err == nil {
+			return fmt.Errorf("ResourcePolicy %q still exists", id.Name)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeResourcePolicy_dailySchedule(policyName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_resource_policy" "policy" {
+  name   = "%s"
+  region = "us-central1"
+
+  snapshot_schedule_policy {
+    schedule {
+      daily_schedule {
+        days_in_cycle = 1
+        start_time    = "04:00"
+      }
+    }
+
+    retention_policy {
+      max_retention_days    = 10
+      on_source_disk_delete = "KEEP_AUTO_SNAPSHOTS"
+    }
+
+    snapshot_properties {
+      labels = {
+        my_label = "value"
+      }
+
+      storage_locations = ["us"]
+      guest_flush       = true
+    }
+  }
+}
+`, policyName)
+}
+
+func testAccComputeResourcePolicy_weeklySchedule(policyName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_resource_policy" "policy" {
+  name   = "%s"
+  region = "us-central1"
+
+  snapshot_schedule_policy {
+    schedule {
+      weekly_schedule {
+        day_of_weeks {
+          day        = "MONDAY"
+          start_time = "02:00"
+        }
+
+        day_of_weeks {
+          day        = "THURSDAY"
+          start_time = "02:00"
+        }
+      }
+    }
+
+    retention_policy {
+      max_retention_days = 30
+    }
+  }
+}
+`, policyName)
+}
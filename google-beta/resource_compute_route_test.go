@@ -11,6 +11,14 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_route", testSweeper{
+		Dependencies: []string{"gcp_compute_instance"},
+		List:         listGlobalComputeResources("routes"),
+		Delete:       sweepComputeResource,
+	})
+}
+
 func TestAccComputeRoute_basic(t *testing.T) {
 	t.Parallel()
 
@@ -8,6 +8,13 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_router", testSweeper{
+		List:   listRegionalComputeResources("routers"),
+		Delete: sweepComputeResource,
+	})
+}
+
 func TestAccComputeRouter_basic(t *testing.T) {
 	t.Parallel()
 
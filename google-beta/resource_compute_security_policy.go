@@ -1,6 +1,7 @@
 package google
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 
@@ -57,7 +58,7 @@ func resourceComputeSecurityPolicy() *schema.Resource {
 						"action": &schema.Schema{
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"allow", "deny(403)", "deny(404)", "deny(502)"}, false),
+							ValidateFunc: validation.StringInSlice([]string{"allow", "deny(403)", "deny(404)", "deny(502)", "throttle", "rate_based_ban", "redirect"}, false),
 						},
 
 						"priority": &schema.Schema{
@@ -73,7 +74,7 @@ func resourceComputeSecurityPolicy() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									"config": &schema.Schema{
 										Type:     schema.TypeList,
-										Required: true,
+										Optional: true,
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
@@ -90,9 +91,85 @@ func resourceComputeSecurityPolicy() *schema.Resource {
 
 									"versioned_expr": &schema.Schema{
 										Type:         schema.TypeString,
-										Required:     true,
+										Optional:     true,
 										ValidateFunc: validation.StringInSlice([]string{"SRC_IPS_V1"}, false),
 									},
+
+									"expr": &schema.Schema{
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"expression": &schema.Schema{
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"rate_limit_options": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"conform_action": &schema.Schema{
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"allow"}, false),
+									},
+
+									"exceed_action": &schema.Schema{
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"deny(403)", "deny(404)", "deny(429)", "deny(502)"}, false),
+									},
+
+									"rate_limit_threshold": securityPolicyThresholdSchema(true),
+
+									"enforce_on_key": &schema.Schema{
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "ALL",
+										ValidateFunc: validation.StringInSlice([]string{"ALL", "IP", "HTTP_HEADER", "XFF_IP", "HTTP_COOKIE"}, false),
+									},
+
+									"enforce_on_key_name": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"ban_duration_sec": &schema.Schema{
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+
+									"ban_threshold": securityPolicyThresholdSchema(false),
+								},
+							},
+						},
+
+						"redirect_options": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": &schema.Schema{
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"EXTERNAL_302", "GOOGLE_RECAPTCHA"}, false),
+									},
+
+									"target": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
 								},
 							},
 						},
@@ -123,6 +200,28 @@ func resourceComputeSecurityPolicy() *schema.Resource {
 	}
 }
 
+func securityPolicyThresholdSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"count": &schema.Schema{
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+
+				"interval_sec": &schema.Schema{
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
 func resourceComputeSecurityPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -132,23 +231,26 @@ func resourceComputeSecurityPolicyCreate(d *schema.ResourceData, meta interface{
 	}
 
 	sp := d.Get("name").(string)
-	securityPolicy := &compute.SecurityPolicy{
-		Name:        sp,
-		Description: d.Get("description").(string),
+	securityPolicy := map[string]interface{}{
+		"name":        sp,
+		"description": d.Get("description").(string),
 	}
 	if v, ok := d.GetOk("rule"); ok {
-		securityPolicy.Rules = expandSecurityPolicyRules(v.(*schema.Set).List())
+		rules, err := expandSecurityPolicyRules(v.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+		securityPolicy["rules"] = rules
 	}
 
 	log.Printf("[DEBUG] SecurityPolicy insert request: %#v", securityPolicy)
 
-	op, err := config.clientComputeBeta.SecurityPolicies.Insert(project, securityPolicy).Do()
-
+	op, err := sendSecurityPolicyRequest(config, "POST", securityPolicyUrl(project, ""), securityPolicy)
 	if err != nil {
 		return errwrap.Wrapf("Error creating SecurityPolicy: {{err}}", err)
 	}
 
-	d.SetId(securityPolicy.Name)
+	d.SetId(sp)
 
 	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Creating SecurityPolicy %q", sp))
 	if err != nil {
@@ -166,14 +268,25 @@ func resourceComputeSecurityPolicyRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	securityPolicy, err := config.clientComputeBeta.SecurityPolicies.Get(project, d.Id()).Do()
+	res, err := sendRequest(config, "GET", securityPolicyUrl(project, d.Id()), nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("SecurityPolicy %q", d.Id()))
 	}
 
+	securityPolicy := &compute.SecurityPolicy{}
+	if err := Convert(res, securityPolicy); err != nil {
+		return err
+	}
+
+	rawRules, _ := res["rules"].([]interface{})
+	rules, err := flattenSecurityPolicyRules(rawRules)
+	if err != nil {
+		return err
+	}
+
 	d.Set("name", securityPolicy.Name)
 	d.Set("description", securityPolicy.Description)
-	if err := d.Set("rule", flattenSecurityPolicyRules(securityPolicy.Rules)); err != nil {
+	if err := d.Set("rule", rules); err != nil {
 		return err
 	}
 	d.Set("fingerprint", securityPolicy.Fingerprint)
@@ -227,7 +340,12 @@ func resourceComputeSecurityPolicyUpdate(d *schema.ResourceData, meta interface{
 			nPriorities[priority] = true
 			if !oPriorities[priority] {
 				// If the rule is in new and its priority does not exist in old, then add it.
-				op, err := config.clientComputeBeta.SecurityPolicies.AddRule(project, sp, expandSecurityPolicyRule(rule)).Do()
+				obj, err := expandSecurityPolicyRule(rule)
+				if err != nil {
+					return err
+				}
+
+				op, err := sendSecurityPolicyRequest(config, "POST", securityPolicyUrl(project, sp)+"/addRule", obj)
 
 				if err != nil {
 					return errwrap.Wrapf(fmt.Sprintf("Error updating SecurityPolicy %q: {{err}}", sp), err)
@@ -239,7 +357,12 @@ func resourceComputeSecurityPolicyUpdate(d *schema.ResourceData, meta interface{
 				}
 			} else if !oSet.Contains(rule) {
 				// If the rule is in new, and its priority is in old, but its hash is different than the one in old, update it.
-				op, err := config.clientComputeBeta.SecurityPolicies.PatchRule(project, sp, expandSecurityPolicyRule(rule)).Priority(priority).Do()
+				obj, err := expandSecurityPolicyRule(rule)
+				if err != nil {
+					return err
+				}
+
+				op, err := sendSecurityPolicyRequest(config, "POST", fmt.Sprintf("%s/patchRule?priority=%d", securityPolicyUrl(project, sp), priority), obj)
 
 				if err != nil {
 					return errwrap.Wrapf(fmt.Sprintf("Error updating SecurityPolicy %q: {{err}}", sp), err)
@@ -296,17 +419,44 @@ func resourceComputeSecurityPolicyDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func expandSecurityPolicyRules(configured []interface{}) []*compute.SecurityPolicyRule {
-	rules := make([]*compute.SecurityPolicyRule, 0, len(configured))
+// Rate limiting and redirect options are missing from the vendored compute
+// client, so policies and their rules are written and read as raw requests.
+func securityPolicyUrl(project, sp string) string {
+	url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/global/securityPolicies", project)
+	if sp != "" {
+		url = fmt.Sprintf("%s/%s", url, sp)
+	}
+	return url
+}
+
+func sendSecurityPolicyRequest(config *Config, method, url string, obj map[string]interface{}) (*compute.Operation, error) {
+	res, err := sendRequest(config, method, url, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func expandSecurityPolicyRules(configured []interface{}) ([]interface{}, error) {
+	rules := make([]interface{}, 0, len(configured))
 	for _, raw := range configured {
-		rules = append(rules, expandSecurityPolicyRule(raw))
+		rule, err := expandSecurityPolicyRule(raw)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
 	}
-	return rules
+	return rules, nil
 }
 
-func expandSecurityPolicyRule(raw interface{}) *compute.SecurityPolicyRule {
+func expandSecurityPolicyRule(raw interface{}) (map[string]interface{}, error) {
 	data := raw.(map[string]interface{})
-	return &compute.SecurityPolicyRule{
+	rule := &compute.SecurityPolicyRule{
 		Description:     data["description"].(string),
 		Priority:        int64(data["priority"].(int)),
 		Action:          data["action"].(string),
@@ -314,6 +464,25 @@ func expandSecurityPolicyRule(raw interface{}) *compute.SecurityPolicyRule {
 		Match:           expandSecurityPolicyMatch(data["match"].([]interface{})),
 		ForceSendFields: []string{"Description", "Preview"},
 	}
+
+	// Convert can only write into structs, so the rule is marshalled by hand.
+	b, err := json.Marshal(rule)
+	if err != nil {
+		return nil, err
+	}
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+
+	if v := expandSecurityPolicyRuleRateLimitOptions(data["rate_limit_options"].([]interface{})); v != nil {
+		obj["rateLimitOptions"] = v
+	}
+	if v := expandSecurityPolicyRuleRedirectOptions(data["redirect_options"].([]interface{})); v != nil {
+		obj["redirectOptions"] = v
+	}
+
+	return obj, nil
 }
 
 func expandSecurityPolicyMatch(configured []interface{}) *compute.SecurityPolicyRuleMatcher {
@@ -325,6 +494,7 @@ func expandSecurityPolicyMatch(configured []interface{}) *compute.SecurityPolicy
 	return &compute.SecurityPolicyRuleMatcher{
 		VersionedExpr: data["versioned_expr"].(string),
 		Config:        expandSecurityPolicyMatchConfig(data["config"].([]interface{})),
+		Expr:          expandSecurityPolicyMatchExpr(data["expr"].([]interface{})),
 	}
 }
 
@@ -339,27 +509,164 @@ func expandSecurityPolicyMatchConfig(configured []interface{}) *compute.Security
 	}
 }
 
-func flattenSecurityPolicyRules(rules []*compute.SecurityPolicyRule) []map[string]interface{} {
+func expandSecurityPolicyMatchExpr(configured []interface{}) *compute.Expr {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+	return &compute.Expr{
+		Expression: data["expression"].(string),
+	}
+}
+
+func expandSecurityPolicyRuleRateLimitOptions(configured []interface{}) map[string]interface{} {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+	options := map[string]interface{}{
+		"conformAction":      data["conform_action"].(string),
+		"exceedAction":       data["exceed_action"].(string),
+		"enforceOnKey":       data["enforce_on_key"].(string),
+		"rateLimitThreshold": expandSecurityPolicyRuleThreshold(data["rate_limit_threshold"].([]interface{})),
+	}
+	if v := data["enforce_on_key_name"].(string); v != "" {
+		options["enforceOnKeyName"] = v
+	}
+	if v := data["ban_duration_sec"].(int); v != 0 {
+		options["banDurationSec"] = v
+	}
+	if v := expandSecurityPolicyRuleThreshold(data["ban_threshold"].([]interface{})); v != nil {
+		options["banThreshold"] = v
+	}
+	return options
+}
+
+func expandSecurityPolicyRuleThreshold(configured []interface{}) map[string]interface{} {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+	return map[string]interface{}{
+		"count":       data["count"].(int),
+		"intervalSec": data["interval_sec"].(int),
+	}
+}
+
+func expandSecurityPolicyRuleRedirectOptions(configured []interface{}) map[string]interface{} {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+	options := map[string]interface{}{
+		"type": data["type"].(string),
+	}
+	if v := data["target"].(string); v != "" {
+		options["target"] = v
+	}
+	return options
+}
+
+func flattenSecurityPolicyRules(rules []interface{}) ([]map[string]interface{}, error) {
 	rulesSchema := make([]map[string]interface{}, 0, len(rules))
-	for _, rule := range rules {
+	for _, raw := range rules {
+		obj := raw.(map[string]interface{})
+		rule := &compute.SecurityPolicyRule{}
+		if err := Convert(obj, rule); err != nil {
+			return nil, err
+		}
+
 		data := map[string]interface{}{
-			"description": rule.Description,
-			"priority":    rule.Priority,
-			"action":      rule.Action,
-			"preview":     rule.Preview,
-			"match": []map[string]interface{}{
-				{
-					"versioned_expr": rule.Match.VersionedExpr,
-					"config": []map[string]interface{}{
-						{
-							"src_ip_ranges": schema.NewSet(schema.HashString, convertStringArrToInterface(rule.Match.Config.SrcIpRanges)),
-						},
-					},
-				},
-			},
+			"description":        rule.Description,
+			"priority":           rule.Priority,
+			"action":             rule.Action,
+			"preview":            rule.Preview,
+			"match":              flattenSecurityPolicyMatch(rule.Match),
+			"rate_limit_options": flattenSecurityPolicyRuleRateLimitOptions(obj["rateLimitOptions"]),
+			"redirect_options":   flattenSecurityPolicyRuleRedirectOptions(obj["redirectOptions"]),
 		}
 
 		rulesSchema = append(rulesSchema, data)
 	}
-	return rulesSchema
+	return rulesSchema, nil
+}
+
+func flattenSecurityPolicyMatch(match *compute.SecurityPolicyRuleMatcher) []map[string]interface{} {
+	if match == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"versioned_expr": match.VersionedExpr,
+	}
+	if match.Config != nil {
+		data["config"] = []map[string]interface{}{
+			{
+				"src_ip_ranges": schema.NewSet(schema.HashString, convertStringArrToInterface(match.Config.SrcIpRanges)),
+			},
+		}
+	}
+	if match.Expr != nil {
+		data["expr"] = []map[string]interface{}{
+			{
+				"expression": match.Expr.Expression,
+			},
+		}
+	}
+	return []map[string]interface{}{data}
+}
+
+func flattenSecurityPolicyRuleRateLimitOptions(v interface{}) []map[string]interface{} {
+	options, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"conform_action":       options["conformAction"],
+		"exceed_action":        options["exceedAction"],
+		"enforce_on_key":       options["enforceOnKey"],
+		"enforce_on_key_name":  options["enforceOnKeyName"],
+		"rate_limit_threshold": flattenSecurityPolicyRuleThreshold(options["rateLimitThreshold"]),
+		"ban_threshold":        flattenSecurityPolicyRuleThreshold(options["banThreshold"]),
+	}
+	// JSON numbers are decoded as float64
+	if v, ok := options["banDurationSec"].(float64); ok {
+		data["ban_duration_sec"] = int(v)
+	}
+	return []map[string]interface{}{data}
+}
+
+func flattenSecurityPolicyRuleThreshold(v interface{}) []map[string]interface{} {
+	threshold, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	data := map[string]interface{}{}
+	if v, ok := threshold["count"].(float64); ok {
+		data["count"] = int(v)
+	}
+	if v, ok := threshold["intervalSec"].(float64); ok {
+		data["interval_sec"] = int(v)
+	}
+	return []map[string]interface{}{data}
+}
+
+func flattenSecurityPolicyRuleRedirectOptions(v interface{}) []map[string]interface{} {
+	options, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"type":   options["type"],
+			"target": options["target"],
+		},
+	}
 }
@@ -93,6 +93,36 @@ func TestAccComputeSecurityPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccComputeSecurityPolicy_withExprRules(t *testing.T) {
+	t.Parallel()
+
+	spName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeSecurityPolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeSecurityPolicy_withExprRules(spName, 100),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_security_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccComputeSecurityPolicy_withExprRules(spName, 500),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_security_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeSecurityPolicyDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -200,3 +230,68 @@ resource "google_compute_security_policy" "policy" {
 }
 `, spName)
 }
+
+func testAccComputeSecurityPolicy_withExprRules(spName string, rateLimit int) string {
+	return fmt.Sprintf(`
+resource "google_compute_security_policy" "policy" {
+	name = "%s"
+
+	rule {
+		action   = "allow"
+		priority = "2147483647"
+		match {
+			versioned_expr = "SRC_IPS_V1"
+			config {
+				src_ip_ranges = ["*"]
+			}
+		}
+		description = "default rule"
+	}
+
+	rule {
+		action   = "deny(403)"
+		priority = "1000"
+		match {
+			expr {
+				expression = "evaluatePreconfiguredExpr('xss-stable')"
+			}
+		}
+		description = "block cross-site scripting"
+	}
+
+	rule {
+		action   = "redirect"
+		priority = "2000"
+		match {
+			expr {
+				expression = "origin.region_code == 'AU'"
+			}
+		}
+		redirect_options {
+			type   = "EXTERNAL_302"
+			target = "https://www.example.com/au"
+		}
+	}
+
+	rule {
+		action   = "throttle"
+		priority = "3000"
+		match {
+			versioned_expr = "SRC_IPS_V1"
+			config {
+				src_ip_ranges = ["*"]
+			}
+		}
+		rate_limit_options {
+			conform_action = "allow"
+			exceed_action  = "deny(429)"
+			enforce_on_key = "IP"
+			rate_limit_threshold {
+				count        = %d
+				interval_sec = 60
+			}
+		}
+	}
+}
+`, spName, rateLimit)
+}
@@ -10,6 +10,14 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_subnetwork", testSweeper{
+		Dependencies: []string{"gcp_compute_instance", "gcp_compute_instance_template", "gcp_compute_forwarding_rule", "gcp_compute_address", "gcp_compute_router", "gcp_container_cluster"},
+		List:         listRegionalComputeResources("subnetworks"),
+		Delete:       sweepComputeResource,
+	})
+}
+
 // Unit tests
 
 func TestIsShrinkageIpCidr(t *testing.T) {
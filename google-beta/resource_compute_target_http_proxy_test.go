@@ -9,6 +9,14 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_target_http_proxy", testSweeper{
+		Dependencies: []string{"gcp_compute_global_forwarding_rule"},
+		List:         listGlobalComputeResources("targetHttpProxies"),
+		Delete:       sweepComputeResource,
+	})
+}
+
 func TestAccComputeTargetHttpProxy_basic(t *testing.T) {
 	t.Parallel()
 
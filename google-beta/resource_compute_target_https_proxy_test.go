@@ -10,6 +10,14 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_target_https_proxy", testSweeper{
+		Dependencies: []string{"gcp_compute_global_forwarding_rule"},
+		List:         listGlobalComputeResources("targetHttpsProxies"),
+		Delete:       sweepComputeResource,
+	})
+}
+
 const (
 	canonicalSslCertificateTemplate = "https://www.googleapis.com/compute/v1/projects/%s/global/sslCertificates/%s"
 )
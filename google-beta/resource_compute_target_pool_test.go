@@ -9,6 +9,14 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_target_pool", testSweeper{
+		Dependencies: []string{"gcp_compute_forwarding_rule"},
+		List:         listRegionalComputeResources("targetPools"),
+		Delete:       sweepComputeResource,
+	})
+}
+
 func TestAccComputeTargetPool_basic(t *testing.T) {
 	t.Parallel()
 
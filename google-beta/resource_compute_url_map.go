@@ -22,9 +22,42 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	compute "google.golang.org/api/compute/v1"
 )
 
+// A url map, and each of its path matchers, must set exactly one of
+// default_service or default_url_redirect.
+func resourceComputeUrlMapDefaultsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validateUrlMapDefaults(diff, "", "url_map"); err != nil {
+		return err
+	}
+
+	for i := range diff.Get("path_matcher").([]interface{}) {
+		prefix := fmt.Sprintf("path_matcher.%d.", i)
+		name := fmt.Sprintf("path_matcher %q", diff.Get(prefix+"name").(string))
+		if err := validateUrlMapDefaults(diff, prefix, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateUrlMapDefaults(diff *schema.ResourceDiff, prefix, name string) error {
+	// A service that isn't known yet (e.g. an interpolated self_link) is set.
+	hasService := !diff.NewValueKnown(prefix+"default_service") || diff.Get(prefix+"default_service").(string) != ""
+	hasRedirect := len(diff.Get(prefix+"default_url_redirect").([]interface{})) > 0
+
+	if hasService && hasRedirect {
+		return fmt.Errorf("%s can only set one of default_service or default_url_redirect", name)
+	}
+	if !hasService && !hasRedirect {
+		return fmt.Errorf("%s must set one of default_service or default_url_redirect", name)
+	}
+	return nil
+}
+
 func resourceComputeUrlMap() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeUrlMapCreate,
@@ -36,6 +69,8 @@ func resourceComputeUrlMap() *schema.Resource {
 			State: resourceComputeUrlMapImport,
 		},
 
+		CustomizeDiff: resourceComputeUrlMapDefaultsCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(240 * time.Second),
 			Update: schema.DefaultTimeout(240 * time.Second),
@@ -45,7 +80,7 @@ func resourceComputeUrlMap() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"default_service": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				DiffSuppressFunc: compareSelfLinkOrResourceName,
 			},
 			"name": {
@@ -57,6 +92,40 @@ func resourceComputeUrlMap() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"default_url_redirect": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"strip_query": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"host_redirect": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"https_redirect": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"path_redirect": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"prefix_redirect": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"redirect_response_code": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"FOUND", "MOVED_PERMANENTLY_DEFAULT", "PERMANENT_REDIRECT", "SEE_OTHER", "TEMPORARY_REDIRECT", ""}, false),
+						},
+					},
+				},
+			},
 			"host_rule": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -89,7 +158,7 @@ func resourceComputeUrlMap() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"default_service": {
 							Type:             schema.TypeString,
-							Required:         true,
+							Optional:         true,
 							DiffSuppressFunc: compareSelfLinkOrResourceName,
 						},
 						"name": {
@@ -100,6 +169,40 @@ func resourceComputeUrlMap() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"default_url_redirect": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"strip_query": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"host_redirect": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"https_redirect": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"path_redirect": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"prefix_redirect": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"redirect_response_code": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"FOUND", "MOVED_PERMANENTLY_DEFAULT", "PERMANENT_REDIRECT", "SEE_OTHER", "TEMPORARY_REDIRECT", ""}, false),
+									},
+								},
+							},
+						},
 						"path_rule": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -121,6 +224,451 @@ func resourceComputeUrlMap() *schema.Resource {
 								},
 							},
 						},
+						"route_rules": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"priority": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"service": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										DiffSuppressFunc: compareSelfLinkOrResourceName,
+									},
+									"header_action": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"request_headers_to_add": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"header_name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"header_value": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"replace": {
+																Type:     schema.TypeBool,
+																Required: true,
+															},
+														},
+													},
+												},
+												"request_headers_to_remove": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"response_headers_to_add": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"header_name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"header_value": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"replace": {
+																Type:     schema.TypeBool,
+																Required: true,
+															},
+														},
+													},
+												},
+												"response_headers_to_remove": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
+									"match_rules": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"full_path_match": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"header_matches": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"header_name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"exact_match": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"invert_match": {
+																Type:     schema.TypeBool,
+																Optional: true,
+															},
+															"prefix_match": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"present_match": {
+																Type:     schema.TypeBool,
+																Optional: true,
+															},
+															"range_match": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"range_end": {
+																			Type:     schema.TypeInt,
+																			Required: true,
+																		},
+																		"range_start": {
+																			Type:     schema.TypeInt,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+															"regex_match": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"suffix_match": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"ignore_case": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"prefix_match": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"query_parameter_matches": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"exact_match": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"present_match": {
+																Type:     schema.TypeBool,
+																Optional: true,
+															},
+															"regex_match": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"regex_match": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"route_action": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"fault_injection_policy": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"abort": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"http_status": {
+																			Type:     schema.TypeInt,
+																			Required: true,
+																		},
+																		"percentage": {
+																			Type:     schema.TypeFloat,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+															"delay": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"percentage": {
+																			Type:     schema.TypeFloat,
+																			Required: true,
+																		},
+																		"fixed_delay": {
+																			Type:     schema.TypeList,
+																			Optional: true,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"seconds": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																					"nanos": {
+																						Type:     schema.TypeInt,
+																						Optional: true,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"request_mirror_policy": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"backend_service": {
+																Type:             schema.TypeString,
+																Required:         true,
+																DiffSuppressFunc: compareSelfLinkOrResourceName,
+															},
+														},
+													},
+												},
+												"retry_policy": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"num_retries": {
+																Type:     schema.TypeInt,
+																Optional: true,
+															},
+															"per_try_timeout": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"seconds": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																		"nanos": {
+																			Type:     schema.TypeInt,
+																			Optional: true,
+																		},
+																	},
+																},
+															},
+															"retry_conditions": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Elem: &schema.Schema{
+																	Type: schema.TypeString,
+																},
+															},
+														},
+													},
+												},
+												"timeout": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"seconds": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"nanos": {
+																Type:     schema.TypeInt,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"url_rewrite": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"host_rewrite": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"path_prefix_rewrite": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"weighted_backend_services": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"backend_service": {
+																Type:             schema.TypeString,
+																Required:         true,
+																DiffSuppressFunc: compareSelfLinkOrResourceName,
+															},
+															"weight": {
+																Type:     schema.TypeInt,
+																Required: true,
+															},
+															"header_action": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"request_headers_to_add": {
+																			Type:     schema.TypeList,
+																			Optional: true,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"header_name": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																					"header_value": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																					"replace": {
+																						Type:     schema.TypeBool,
+																						Required: true,
+																					},
+																				},
+																			},
+																		},
+																		"request_headers_to_remove": {
+																			Type:     schema.TypeList,
+																			Optional: true,
+																			Elem: &schema.Schema{
+																				Type: schema.TypeString,
+																			},
+																		},
+																		"response_headers_to_add": {
+																			Type:     schema.TypeList,
+																			Optional: true,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"header_name": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																					"header_value": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																					"replace": {
+																						Type:     schema.TypeBool,
+																						Required: true,
+																					},
+																				},
+																			},
+																		},
+																		"response_headers_to_remove": {
+																			Type:     schema.TypeList,
+																			Optional: true,
+																			Elem: &schema.Schema{
+																				Type: schema.TypeString,
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"url_redirect": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"strip_query": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+												"host_redirect": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"https_redirect": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"path_redirect": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"prefix_redirect": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"redirect_response_code": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice([]string{"FOUND", "MOVED_PERMANENTLY_DEFAULT", "PERMANENT_REDIRECT", "SEE_OTHER", "TEMPORARY_REDIRECT", ""}, false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -185,6 +733,12 @@ func resourceComputeUrlMapCreate(d *schema.ResourceData, meta interface{}) error
 	} else if v, ok := d.GetOkExists("default_service"); !isEmptyValue(reflect.ValueOf(defaultServiceProp)) && (ok || !reflect.DeepEqual(v, defaultServiceProp)) {
 		obj["defaultService"] = defaultServiceProp
 	}
+	defaultUrlRedirectProp, err := expandComputeUrlMapDefaultUrlRedirect(d.Get("default_url_redirect"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("default_url_redirect"); !isEmptyValue(reflect.ValueOf(defaultUrlRedirectProp)) && (ok || !reflect.DeepEqual(v, defaultUrlRedirectProp)) {
+		obj["defaultUrlRedirect"] = defaultUrlRedirectProp
+	}
 	descriptionProp, err := expandComputeUrlMapDescription(d.Get("description"), d, config)
 	if err != nil {
 		return err
@@ -292,6 +846,9 @@ func resourceComputeUrlMapRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("default_service", flattenComputeUrlMapDefaultService(res["defaultService"], d)); err != nil {
 		return fmt.Errorf("Error reading UrlMap: %s", err)
 	}
+	if err := d.Set("default_url_redirect", flattenComputeUrlMapDefaultUrlRedirect(res["defaultUrlRedirect"], d)); err != nil {
+		return fmt.Errorf("Error reading UrlMap: %s", err)
+	}
 	if err := d.Set("description", flattenComputeUrlMapDescription(res["description"], d)); err != nil {
 		return fmt.Errorf("Error reading UrlMap: %s", err)
 	}
@@ -330,6 +887,12 @@ func resourceComputeUrlMapUpdate(d *schema.ResourceData, meta interface{}) error
 	} else if v, ok := d.GetOkExists("default_service"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, defaultServiceProp)) {
 		obj["defaultService"] = defaultServiceProp
 	}
+	defaultUrlRedirectProp, err := expandComputeUrlMapDefaultUrlRedirect(d.Get("default_url_redirect"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("default_url_redirect"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, defaultUrlRedirectProp)) {
+		obj["defaultUrlRedirect"] = defaultUrlRedirectProp
+	}
 	descriptionProp, err := expandComputeUrlMapDescription(d.Get("description"), d, config)
 	if err != nil {
 		return err
@@ -462,6 +1025,54 @@ func flattenComputeUrlMapDefaultService(v interface{}, d *schema.ResourceData) i
 	return ConvertSelfLinkToV1(v.(string))
 }
 
+func flattenComputeUrlMapDefaultUrlRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["host_redirect"] =
+		flattenComputeUrlMapDefaultUrlRedirectHostRedirect(original["hostRedirect"], d)
+	transformed["https_redirect"] =
+		flattenComputeUrlMapDefaultUrlRedirectHttpsRedirect(original["httpsRedirect"], d)
+	transformed["path_redirect"] =
+		flattenComputeUrlMapDefaultUrlRedirectPathRedirect(original["pathRedirect"], d)
+	transformed["prefix_redirect"] =
+		flattenComputeUrlMapDefaultUrlRedirectPrefixRedirect(original["prefixRedirect"], d)
+	transformed["redirect_response_code"] =
+		flattenComputeUrlMapDefaultUrlRedirectRedirectResponseCode(original["redirectResponseCode"], d)
+	transformed["strip_query"] =
+		flattenComputeUrlMapDefaultUrlRedirectStripQuery(original["stripQuery"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapDefaultUrlRedirectHostRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapDefaultUrlRedirectHttpsRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapDefaultUrlRedirectPathRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapDefaultUrlRedirectPrefixRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapDefaultUrlRedirectRedirectResponseCode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapDefaultUrlRedirectStripQuery(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
 func flattenComputeUrlMapDescription(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -532,10 +1143,12 @@ func flattenComputeUrlMapPath_matcher(v interface{}, d *schema.ResourceData) int
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"default_service": flattenComputeUrlMapPath_matcherDefaultService(original["defaultService"], d),
-			"description":     flattenComputeUrlMapPath_matcherDescription(original["description"], d),
-			"name":            flattenComputeUrlMapPath_matcherName(original["name"], d),
-			"path_rule":       flattenComputeUrlMapPath_matcherPath_rule(original["pathRules"], d),
+			"default_service":      flattenComputeUrlMapPath_matcherDefaultService(original["defaultService"], d),
+			"default_url_redirect": flattenComputeUrlMapPath_matcherDefaultUrlRedirect(original["defaultUrlRedirect"], d),
+			"description":          flattenComputeUrlMapPath_matcherDescription(original["description"], d),
+			"name":                 flattenComputeUrlMapPath_matcherName(original["name"], d),
+			"path_rule":            flattenComputeUrlMapPath_matcherPath_rule(original["pathRules"], d),
+			"route_rules":          flattenComputeUrlMapPath_matcherRouteRules(original["routeRules"], d),
 		})
 	}
 	return transformed
@@ -547,6 +1160,54 @@ func flattenComputeUrlMapPath_matcherDefaultService(v interface{}, d *schema.Res
 	return ConvertSelfLinkToV1(v.(string))
 }
 
+func flattenComputeUrlMapPath_matcherDefaultUrlRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["host_redirect"] =
+		flattenComputeUrlMapPath_matcherDefaultUrlRedirectHostRedirect(original["hostRedirect"], d)
+	transformed["https_redirect"] =
+		flattenComputeUrlMapPath_matcherDefaultUrlRedirectHttpsRedirect(original["httpsRedirect"], d)
+	transformed["path_redirect"] =
+		flattenComputeUrlMapPath_matcherDefaultUrlRedirectPathRedirect(original["pathRedirect"], d)
+	transformed["prefix_redirect"] =
+		flattenComputeUrlMapPath_matcherDefaultUrlRedirectPrefixRedirect(original["prefixRedirect"], d)
+	transformed["redirect_response_code"] =
+		flattenComputeUrlMapPath_matcherDefaultUrlRedirectRedirectResponseCode(original["redirectResponseCode"], d)
+	transformed["strip_query"] =
+		flattenComputeUrlMapPath_matcherDefaultUrlRedirectStripQuery(original["stripQuery"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherDefaultUrlRedirectHostRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherDefaultUrlRedirectHttpsRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherDefaultUrlRedirectPathRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherDefaultUrlRedirectPrefixRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherDefaultUrlRedirectRedirectResponseCode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherDefaultUrlRedirectStripQuery(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
 func flattenComputeUrlMapPath_matcherDescription(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -588,7 +1249,7 @@ func flattenComputeUrlMapPath_matcherPath_ruleService(v interface{}, d *schema.R
 	return ConvertSelfLinkToV1(v.(string))
 }
 
-func flattenComputeUrlMapTest(v interface{}, d *schema.ResourceData) interface{} {
+func flattenComputeUrlMapPath_matcherRouteRules(v interface{}, d *schema.ResourceData) interface{} {
 	if v == nil {
 		return v
 	}
@@ -601,104 +1262,1927 @@ func flattenComputeUrlMapTest(v interface{}, d *schema.ResourceData) interface{}
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"description": flattenComputeUrlMapTestDescription(original["description"], d),
-			"host":        flattenComputeUrlMapTestHost(original["host"], d),
-			"path":        flattenComputeUrlMapTestPath(original["path"], d),
-			"service":     flattenComputeUrlMapTestService(original["service"], d),
+			"header_action": flattenComputeUrlMapPath_matcherRouteRulesHeaderAction(original["headerAction"], d),
+			"match_rules":   flattenComputeUrlMapPath_matcherRouteRulesMatchRules(original["matchRules"], d),
+			"priority":      flattenComputeUrlMapPath_matcherRouteRulesPriority(original["priority"], d),
+			"route_action":  flattenComputeUrlMapPath_matcherRouteRulesRouteAction(original["routeAction"], d),
+			"service":       flattenComputeUrlMapPath_matcherRouteRulesService(original["service"], d),
+			"url_redirect":  flattenComputeUrlMapPath_matcherRouteRulesUrlRedirect(original["urlRedirect"], d),
 		})
 	}
 	return transformed
 }
-func flattenComputeUrlMapTestDescription(v interface{}, d *schema.ResourceData) interface{} {
+
+func flattenComputeUrlMapPath_matcherRouteRulesHeaderAction(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["request_headers_to_add"] =
+		flattenComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAdd(original["requestHeadersToAdd"], d)
+	transformed["request_headers_to_remove"] =
+		flattenComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToRemove(original["requestHeadersToRemove"], d)
+	transformed["response_headers_to_add"] =
+		flattenComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAdd(original["responseHeadersToAdd"], d)
+	transformed["response_headers_to_remove"] =
+		flattenComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToRemove(original["responseHeadersToRemove"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAdd(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"header_name":  flattenComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAddHeaderName(original["headerName"], d),
+			"header_value": flattenComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAddHeaderValue(original["headerValue"], d),
+			"replace":      flattenComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAddReplace(original["replace"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAddHeaderName(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
 
-func flattenComputeUrlMapTestHost(v interface{}, d *schema.ResourceData) interface{} {
+func flattenComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAddHeaderValue(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
 
-func flattenComputeUrlMapTestPath(v interface{}, d *schema.ResourceData) interface{} {
+func flattenComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAddReplace(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
 
-func flattenComputeUrlMapTestService(v interface{}, d *schema.ResourceData) interface{} {
+func flattenComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToRemove(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAdd(v interface{}, d *schema.ResourceData) interface{} {
 	if v == nil {
 		return v
 	}
-	return ConvertSelfLinkToV1(v.(string))
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"header_name":  flattenComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAddHeaderName(original["headerName"], d),
+			"header_value": flattenComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAddHeaderValue(original["headerValue"], d),
+			"replace":      flattenComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAddReplace(original["replace"], d),
+		})
+	}
+	return transformed
 }
 
-// ResourceRef only supports 1 type and UrlMap has references to a BackendBucket or BackendService. Just read the self_link string
-// instead of extracting the name and making a self_link out of it.
-func expandComputeUrlMapDefaultService(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
-	return v, nil
+func flattenComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAddHeaderName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
 }
 
-func expandComputeUrlMapDescription(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
-	return v, nil
+func flattenComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAddHeaderValue(v interface{}, d *schema.ResourceData) interface{} {
+	return v
 }
 
-func expandComputeUrlMapHost_rule(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
-	v = v.(*schema.Set).List()
+func flattenComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAddReplace(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToRemove(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRules(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
 	l := v.([]interface{})
-	req := make([]interface{}, 0, len(l))
+	transformed := make([]interface{}, 0, len(l))
 	for _, raw := range l {
-		if raw == nil {
-			continue
-		}
 		original := raw.(map[string]interface{})
-		transformed := make(map[string]interface{})
-
-		transformedDescription, err := expandComputeUrlMapHost_ruleDescription(original["description"], d, config)
-		if err != nil {
-			return nil, err
-		} else if val := reflect.ValueOf(transformedDescription); val.IsValid() && !isEmptyValue(val) {
-			transformed["description"] = transformedDescription
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
 		}
+		transformed = append(transformed, map[string]interface{}{
+			"full_path_match":         flattenComputeUrlMapPath_matcherRouteRulesMatchRulesFullPathMatch(original["fullPathMatch"], d),
+			"header_matches":          flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatches(original["headerMatches"], d),
+			"ignore_case":             flattenComputeUrlMapPath_matcherRouteRulesMatchRulesIgnoreCase(original["ignoreCase"], d),
+			"prefix_match":            flattenComputeUrlMapPath_matcherRouteRulesMatchRulesPrefixMatch(original["prefixMatch"], d),
+			"query_parameter_matches": flattenComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatches(original["queryParameterMatches"], d),
+			"regex_match":             flattenComputeUrlMapPath_matcherRouteRulesMatchRulesRegexMatch(original["regexMatch"], d),
+		})
+	}
+	return transformed
+}
 
-		transformedHosts, err := expandComputeUrlMapHost_ruleHosts(original["hosts"], d, config)
-		if err != nil {
-			return nil, err
-		} else if val := reflect.ValueOf(transformedHosts); val.IsValid() && !isEmptyValue(val) {
-			transformed["hosts"] = transformedHosts
-		}
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesFullPathMatch(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
 
-		transformedPathMatcher, err := expandComputeUrlMapHost_rulePathMatcher(original["path_matcher"], d, config)
-		if err != nil {
-			return nil, err
-		} else if val := reflect.ValueOf(transformedPathMatcher); val.IsValid() && !isEmptyValue(val) {
-			transformed["pathMatcher"] = transformedPathMatcher
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatches(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
 		}
-
-		req = append(req, transformed)
+		transformed = append(transformed, map[string]interface{}{
+			"exact_match":   flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesExactMatch(original["exactMatch"], d),
+			"header_name":   flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesHeaderName(original["headerName"], d),
+			"invert_match":  flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesInvertMatch(original["invertMatch"], d),
+			"prefix_match":  flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesPrefixMatch(original["prefixMatch"], d),
+			"present_match": flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesPresentMatch(original["presentMatch"], d),
+			"range_match":   flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRangeMatch(original["rangeMatch"], d),
+			"regex_match":   flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRegexMatch(original["regexMatch"], d),
+			"suffix_match":  flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesSuffixMatch(original["suffixMatch"], d),
+		})
 	}
-	return req, nil
+	return transformed
 }
 
-func expandComputeUrlMapHost_ruleDescription(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
-	return v, nil
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesExactMatch(v interface{}, d *schema.ResourceData) interface{} {
+	return v
 }
 
-func expandComputeUrlMapHost_ruleHosts(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
-	v = v.(*schema.Set).List()
-	return v, nil
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesHeaderName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
 }
 
-func expandComputeUrlMapHost_rulePathMatcher(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
-	return v, nil
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesInvertMatch(v interface{}, d *schema.ResourceData) interface{} {
+	return v
 }
 
-func expandComputeUrlMapFingerprint(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
-	return v, nil
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesPrefixMatch(v interface{}, d *schema.ResourceData) interface{} {
+	return v
 }
 
-func expandComputeUrlMapName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
-	return v, nil
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesPresentMatch(v interface{}, d *schema.ResourceData) interface{} {
+	return v
 }
 
-func expandComputeUrlMapPath_matcher(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
-	l := v.([]interface{})
-	req := make([]interface{}, 0, len(l))
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRangeMatch(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["range_end"] =
+		flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRangeMatchRangeEnd(original["rangeEnd"], d)
+	transformed["range_start"] =
+		flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRangeMatchRangeStart(original["rangeStart"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRangeMatchRangeEnd(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRangeMatchRangeStart(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRegexMatch(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesSuffixMatch(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesIgnoreCase(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesPrefixMatch(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatches(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"exact_match":   flattenComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesExactMatch(original["exactMatch"], d),
+			"name":          flattenComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesName(original["name"], d),
+			"present_match": flattenComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesPresentMatch(original["presentMatch"], d),
+			"regex_match":   flattenComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesRegexMatch(original["regexMatch"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesExactMatch(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesPresentMatch(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesRegexMatch(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesMatchRulesRegexMatch(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesPriority(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteAction(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["fault_injection_policy"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicy(original["faultInjectionPolicy"], d)
+	transformed["request_mirror_policy"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionRequestMirrorPolicy(original["requestMirrorPolicy"], d)
+	transformed["retry_policy"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicy(original["retryPolicy"], d)
+	transformed["timeout"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionTimeout(original["timeout"], d)
+	transformed["url_rewrite"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionUrlRewrite(original["urlRewrite"], d)
+	transformed["weighted_backend_services"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServices(original["weightedBackendServices"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicy(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["abort"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyAbort(original["abort"], d)
+	transformed["delay"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelay(original["delay"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyAbort(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["http_status"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyAbortHttpStatus(original["httpStatus"], d)
+	transformed["percentage"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyAbortPercentage(original["percentage"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyAbortHttpStatus(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyAbortPercentage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelay(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["fixed_delay"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayFixedDelay(original["fixedDelay"], d)
+	transformed["percentage"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayPercentage(original["percentage"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayFixedDelay(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["nanos"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayFixedDelayNanos(original["nanos"], d)
+	transformed["seconds"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayFixedDelaySeconds(original["seconds"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayFixedDelayNanos(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayFixedDelaySeconds(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayPercentage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionRequestMirrorPolicy(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["backend_service"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionRequestMirrorPolicyBackendService(original["backendService"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionRequestMirrorPolicyBackendService(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return ConvertSelfLinkToV1(v.(string))
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicy(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["num_retries"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyNumRetries(original["numRetries"], d)
+	transformed["per_try_timeout"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyPerTryTimeout(original["perTryTimeout"], d)
+	transformed["retry_conditions"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyRetryConditions(original["retryConditions"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyNumRetries(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyPerTryTimeout(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["nanos"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyPerTryTimeoutNanos(original["nanos"], d)
+	transformed["seconds"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyPerTryTimeoutSeconds(original["seconds"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyPerTryTimeoutNanos(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyPerTryTimeoutSeconds(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyRetryConditions(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionTimeout(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["nanos"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionTimeoutNanos(original["nanos"], d)
+	transformed["seconds"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionTimeoutSeconds(original["seconds"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionTimeoutNanos(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionTimeoutSeconds(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionUrlRewrite(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["host_rewrite"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionUrlRewriteHostRewrite(original["hostRewrite"], d)
+	transformed["path_prefix_rewrite"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionUrlRewritePathPrefixRewrite(original["pathPrefixRewrite"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionUrlRewriteHostRewrite(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionUrlRewritePathPrefixRewrite(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServices(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"backend_service": flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesBackendService(original["backendService"], d),
+			"header_action":   flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderAction(original["headerAction"], d),
+			"weight":          flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesWeight(original["weight"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesBackendService(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return ConvertSelfLinkToV1(v.(string))
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderAction(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["request_headers_to_add"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAdd(original["requestHeadersToAdd"], d)
+	transformed["request_headers_to_remove"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToRemove(original["requestHeadersToRemove"], d)
+	transformed["response_headers_to_add"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAdd(original["responseHeadersToAdd"], d)
+	transformed["response_headers_to_remove"] =
+		flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToRemove(original["responseHeadersToRemove"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAdd(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"header_name":  flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAddHeaderName(original["headerName"], d),
+			"header_value": flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAddHeaderValue(original["headerValue"], d),
+			"replace":      flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAddReplace(original["replace"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAddHeaderName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAddHeaderValue(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAddReplace(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToRemove(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAdd(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"header_name":  flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAddHeaderName(original["headerName"], d),
+			"header_value": flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAddHeaderValue(original["headerValue"], d),
+			"replace":      flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAddReplace(original["replace"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAddHeaderName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAddHeaderValue(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAddReplace(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToRemove(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesWeight(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesService(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return ConvertSelfLinkToV1(v.(string))
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesUrlRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["host_redirect"] =
+		flattenComputeUrlMapPath_matcherRouteRulesUrlRedirectHostRedirect(original["hostRedirect"], d)
+	transformed["https_redirect"] =
+		flattenComputeUrlMapPath_matcherRouteRulesUrlRedirectHttpsRedirect(original["httpsRedirect"], d)
+	transformed["path_redirect"] =
+		flattenComputeUrlMapPath_matcherRouteRulesUrlRedirectPathRedirect(original["pathRedirect"], d)
+	transformed["prefix_redirect"] =
+		flattenComputeUrlMapPath_matcherRouteRulesUrlRedirectPrefixRedirect(original["prefixRedirect"], d)
+	transformed["redirect_response_code"] =
+		flattenComputeUrlMapPath_matcherRouteRulesUrlRedirectRedirectResponseCode(original["redirectResponseCode"], d)
+	transformed["strip_query"] =
+		flattenComputeUrlMapPath_matcherRouteRulesUrlRedirectStripQuery(original["stripQuery"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesUrlRedirectHostRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesUrlRedirectHttpsRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesUrlRedirectPathRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesUrlRedirectPrefixRedirect(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesUrlRedirectRedirectResponseCode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapPath_matcherRouteRulesUrlRedirectStripQuery(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapTest(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"description": flattenComputeUrlMapTestDescription(original["description"], d),
+			"host":        flattenComputeUrlMapTestHost(original["host"], d),
+			"path":        flattenComputeUrlMapTestPath(original["path"], d),
+			"service":     flattenComputeUrlMapTestService(original["service"], d),
+		})
+	}
+	return transformed
+}
+func flattenComputeUrlMapTestDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapTestHost(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapTestPath(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeUrlMapTestService(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return ConvertSelfLinkToV1(v.(string))
+}
+
+// ResourceRef only supports 1 type and UrlMap has references to a BackendBucket or BackendService. Just read the self_link string
+// instead of extracting the name and making a self_link out of it.
+func expandComputeUrlMapDefaultService(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapDefaultUrlRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedHostRedirect, err := expandComputeUrlMapDefaultUrlRedirectHostRedirect(original["host_redirect"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedHostRedirect); val.IsValid() && !isEmptyValue(val) {
+		transformed["hostRedirect"] = transformedHostRedirect
+	}
+
+	transformedHttpsRedirect, err := expandComputeUrlMapDefaultUrlRedirectHttpsRedirect(original["https_redirect"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedHttpsRedirect); val.IsValid() && !isEmptyValue(val) {
+		transformed["httpsRedirect"] = transformedHttpsRedirect
+	}
+
+	transformedPathRedirect, err := expandComputeUrlMapDefaultUrlRedirectPathRedirect(original["path_redirect"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPathRedirect); val.IsValid() && !isEmptyValue(val) {
+		transformed["pathRedirect"] = transformedPathRedirect
+	}
+
+	transformedPrefixRedirect, err := expandComputeUrlMapDefaultUrlRedirectPrefixRedirect(original["prefix_redirect"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPrefixRedirect); val.IsValid() && !isEmptyValue(val) {
+		transformed["prefixRedirect"] = transformedPrefixRedirect
+	}
+
+	transformedRedirectResponseCode, err := expandComputeUrlMapDefaultUrlRedirectRedirectResponseCode(original["redirect_response_code"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRedirectResponseCode); val.IsValid() && !isEmptyValue(val) {
+		transformed["redirectResponseCode"] = transformedRedirectResponseCode
+	}
+
+	transformedStripQuery, err := expandComputeUrlMapDefaultUrlRedirectStripQuery(original["strip_query"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedStripQuery); val.IsValid() && !isEmptyValue(val) {
+		transformed["stripQuery"] = transformedStripQuery
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapDefaultUrlRedirectHostRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapDefaultUrlRedirectHttpsRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapDefaultUrlRedirectPathRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapDefaultUrlRedirectPrefixRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapDefaultUrlRedirectRedirectResponseCode(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapDefaultUrlRedirectStripQuery(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapDescription(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapHost_rule(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	v = v.(*schema.Set).List()
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedDescription, err := expandComputeUrlMapHost_ruleDescription(original["description"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedDescription); val.IsValid() && !isEmptyValue(val) {
+			transformed["description"] = transformedDescription
+		}
+
+		transformedHosts, err := expandComputeUrlMapHost_ruleHosts(original["hosts"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedHosts); val.IsValid() && !isEmptyValue(val) {
+			transformed["hosts"] = transformedHosts
+		}
+
+		transformedPathMatcher, err := expandComputeUrlMapHost_rulePathMatcher(original["path_matcher"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPathMatcher); val.IsValid() && !isEmptyValue(val) {
+			transformed["pathMatcher"] = transformedPathMatcher
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeUrlMapHost_ruleDescription(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapHost_ruleHosts(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	v = v.(*schema.Set).List()
+	return v, nil
+}
+
+func expandComputeUrlMapHost_rulePathMatcher(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapFingerprint(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcher(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedDefaultService, err := expandComputeUrlMapPath_matcherDefaultService(original["default_service"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedDefaultService); val.IsValid() && !isEmptyValue(val) {
+			transformed["defaultService"] = transformedDefaultService
+		}
+
+		transformedDefaultUrlRedirect, err := expandComputeUrlMapPath_matcherDefaultUrlRedirect(original["default_url_redirect"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedDefaultUrlRedirect); val.IsValid() && !isEmptyValue(val) {
+			transformed["defaultUrlRedirect"] = transformedDefaultUrlRedirect
+		}
+
+		transformedDescription, err := expandComputeUrlMapPath_matcherDescription(original["description"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedDescription); val.IsValid() && !isEmptyValue(val) {
+			transformed["description"] = transformedDescription
+		}
+
+		transformedName, err := expandComputeUrlMapPath_matcherName(original["name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+			transformed["name"] = transformedName
+		}
+
+		transformedPath_rule, err := expandComputeUrlMapPath_matcherPath_rule(original["path_rule"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPath_rule); val.IsValid() && !isEmptyValue(val) {
+			transformed["pathRules"] = transformedPath_rule
+		}
+
+		transformedRouteRules, err := expandComputeUrlMapPath_matcherRouteRules(original["route_rules"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedRouteRules); val.IsValid() && !isEmptyValue(val) {
+			transformed["routeRules"] = transformedRouteRules
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+// ResourceRef only supports 1 type and UrlMap has references to a BackendBucket or BackendService. Just read the self_link string
+// instead of extracting the name and making a self_link out of it.
+func expandComputeUrlMapPath_matcherDefaultService(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherDefaultUrlRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedHostRedirect, err := expandComputeUrlMapPath_matcherDefaultUrlRedirectHostRedirect(original["host_redirect"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedHostRedirect); val.IsValid() && !isEmptyValue(val) {
+		transformed["hostRedirect"] = transformedHostRedirect
+	}
+
+	transformedHttpsRedirect, err := expandComputeUrlMapPath_matcherDefaultUrlRedirectHttpsRedirect(original["https_redirect"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedHttpsRedirect); val.IsValid() && !isEmptyValue(val) {
+		transformed["httpsRedirect"] = transformedHttpsRedirect
+	}
+
+	transformedPathRedirect, err := expandComputeUrlMapPath_matcherDefaultUrlRedirectPathRedirect(original["path_redirect"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPathRedirect); val.IsValid() && !isEmptyValue(val) {
+		transformed["pathRedirect"] = transformedPathRedirect
+	}
+
+	transformedPrefixRedirect, err := expandComputeUrlMapPath_matcherDefaultUrlRedirectPrefixRedirect(original["prefix_redirect"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPrefixRedirect); val.IsValid() && !isEmptyValue(val) {
+		transformed["prefixRedirect"] = transformedPrefixRedirect
+	}
+
+	transformedRedirectResponseCode, err := expandComputeUrlMapPath_matcherDefaultUrlRedirectRedirectResponseCode(original["redirect_response_code"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRedirectResponseCode); val.IsValid() && !isEmptyValue(val) {
+		transformed["redirectResponseCode"] = transformedRedirectResponseCode
+	}
+
+	transformedStripQuery, err := expandComputeUrlMapPath_matcherDefaultUrlRedirectStripQuery(original["strip_query"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedStripQuery); val.IsValid() && !isEmptyValue(val) {
+		transformed["stripQuery"] = transformedStripQuery
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapPath_matcherDefaultUrlRedirectHostRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherDefaultUrlRedirectHttpsRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherDefaultUrlRedirectPathRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherDefaultUrlRedirectPrefixRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherDefaultUrlRedirectRedirectResponseCode(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherDefaultUrlRedirectStripQuery(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherDescription(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherPath_rule(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedPaths, err := expandComputeUrlMapPath_matcherPath_rulePaths(original["paths"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPaths); val.IsValid() && !isEmptyValue(val) {
+			transformed["paths"] = transformedPaths
+		}
+
+		transformedService, err := expandComputeUrlMapPath_matcherPath_ruleService(original["service"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedService); val.IsValid() && !isEmptyValue(val) {
+			transformed["service"] = transformedService
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeUrlMapPath_matcherPath_rulePaths(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	v = v.(*schema.Set).List()
+	return v, nil
+}
+
+// ResourceRef only supports 1 type and UrlMap has references to a BackendBucket or BackendService. Just read the self_link string
+// instead of extracting the name and making a self_link out of it.
+func expandComputeUrlMapPath_matcherPath_ruleService(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRules(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedHeaderAction, err := expandComputeUrlMapPath_matcherRouteRulesHeaderAction(original["header_action"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedHeaderAction); val.IsValid() && !isEmptyValue(val) {
+			transformed["headerAction"] = transformedHeaderAction
+		}
+
+		transformedMatchRules, err := expandComputeUrlMapPath_matcherRouteRulesMatchRules(original["match_rules"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedMatchRules); val.IsValid() && !isEmptyValue(val) {
+			transformed["matchRules"] = transformedMatchRules
+		}
+
+		transformedPriority, err := expandComputeUrlMapPath_matcherRouteRulesPriority(original["priority"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPriority); val.IsValid() && !isEmptyValue(val) {
+			transformed["priority"] = transformedPriority
+		}
+
+		transformedRouteAction, err := expandComputeUrlMapPath_matcherRouteRulesRouteAction(original["route_action"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedRouteAction); val.IsValid() && !isEmptyValue(val) {
+			transformed["routeAction"] = transformedRouteAction
+		}
+
+		transformedService, err := expandComputeUrlMapPath_matcherRouteRulesService(original["service"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedService); val.IsValid() && !isEmptyValue(val) {
+			transformed["service"] = transformedService
+		}
+
+		transformedUrlRedirect, err := expandComputeUrlMapPath_matcherRouteRulesUrlRedirect(original["url_redirect"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedUrlRedirect); val.IsValid() && !isEmptyValue(val) {
+			transformed["urlRedirect"] = transformedUrlRedirect
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesHeaderAction(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedRequestHeadersToAdd, err := expandComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAdd(original["request_headers_to_add"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRequestHeadersToAdd); val.IsValid() && !isEmptyValue(val) {
+		transformed["requestHeadersToAdd"] = transformedRequestHeadersToAdd
+	}
+
+	transformedRequestHeadersToRemove, err := expandComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToRemove(original["request_headers_to_remove"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRequestHeadersToRemove); val.IsValid() && !isEmptyValue(val) {
+		transformed["requestHeadersToRemove"] = transformedRequestHeadersToRemove
+	}
+
+	transformedResponseHeadersToAdd, err := expandComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAdd(original["response_headers_to_add"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedResponseHeadersToAdd); val.IsValid() && !isEmptyValue(val) {
+		transformed["responseHeadersToAdd"] = transformedResponseHeadersToAdd
+	}
+
+	transformedResponseHeadersToRemove, err := expandComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToRemove(original["response_headers_to_remove"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedResponseHeadersToRemove); val.IsValid() && !isEmptyValue(val) {
+		transformed["responseHeadersToRemove"] = transformedResponseHeadersToRemove
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAdd(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedHeaderName, err := expandComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAddHeaderName(original["header_name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedHeaderName); val.IsValid() && !isEmptyValue(val) {
+			transformed["headerName"] = transformedHeaderName
+		}
+
+		transformedHeaderValue, err := expandComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAddHeaderValue(original["header_value"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedHeaderValue); val.IsValid() && !isEmptyValue(val) {
+			transformed["headerValue"] = transformedHeaderValue
+		}
+
+		transformedReplace, err := expandComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAddReplace(original["replace"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedReplace); val.IsValid() && !isEmptyValue(val) {
+			transformed["replace"] = transformedReplace
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAddHeaderName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAddHeaderValue(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToAddReplace(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesHeaderActionRequestHeadersToRemove(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAdd(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedHeaderName, err := expandComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAddHeaderName(original["header_name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedHeaderName); val.IsValid() && !isEmptyValue(val) {
+			transformed["headerName"] = transformedHeaderName
+		}
+
+		transformedHeaderValue, err := expandComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAddHeaderValue(original["header_value"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedHeaderValue); val.IsValid() && !isEmptyValue(val) {
+			transformed["headerValue"] = transformedHeaderValue
+		}
+
+		transformedReplace, err := expandComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAddReplace(original["replace"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedReplace); val.IsValid() && !isEmptyValue(val) {
+			transformed["replace"] = transformedReplace
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAddHeaderName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAddHeaderValue(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToAddReplace(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesHeaderActionResponseHeadersToRemove(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRules(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedFullPathMatch, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesFullPathMatch(original["full_path_match"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedFullPathMatch); val.IsValid() && !isEmptyValue(val) {
+			transformed["fullPathMatch"] = transformedFullPathMatch
+		}
+
+		transformedHeaderMatches, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatches(original["header_matches"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedHeaderMatches); val.IsValid() && !isEmptyValue(val) {
+			transformed["headerMatches"] = transformedHeaderMatches
+		}
+
+		transformedIgnoreCase, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesIgnoreCase(original["ignore_case"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedIgnoreCase); val.IsValid() && !isEmptyValue(val) {
+			transformed["ignoreCase"] = transformedIgnoreCase
+		}
+
+		transformedPrefixMatch, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesPrefixMatch(original["prefix_match"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPrefixMatch); val.IsValid() && !isEmptyValue(val) {
+			transformed["prefixMatch"] = transformedPrefixMatch
+		}
+
+		transformedQueryParameterMatches, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatches(original["query_parameter_matches"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedQueryParameterMatches); val.IsValid() && !isEmptyValue(val) {
+			transformed["queryParameterMatches"] = transformedQueryParameterMatches
+		}
+
+		transformedRegexMatch, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesRegexMatch(original["regex_match"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedRegexMatch); val.IsValid() && !isEmptyValue(val) {
+			transformed["regexMatch"] = transformedRegexMatch
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesFullPathMatch(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatches(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedExactMatch, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesExactMatch(original["exact_match"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedExactMatch); val.IsValid() && !isEmptyValue(val) {
+			transformed["exactMatch"] = transformedExactMatch
+		}
+
+		transformedHeaderName, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesHeaderName(original["header_name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedHeaderName); val.IsValid() && !isEmptyValue(val) {
+			transformed["headerName"] = transformedHeaderName
+		}
+
+		transformedInvertMatch, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesInvertMatch(original["invert_match"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedInvertMatch); val.IsValid() && !isEmptyValue(val) {
+			transformed["invertMatch"] = transformedInvertMatch
+		}
+
+		transformedPrefixMatch, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesPrefixMatch(original["prefix_match"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPrefixMatch); val.IsValid() && !isEmptyValue(val) {
+			transformed["prefixMatch"] = transformedPrefixMatch
+		}
+
+		transformedPresentMatch, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesPresentMatch(original["present_match"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPresentMatch); val.IsValid() && !isEmptyValue(val) {
+			transformed["presentMatch"] = transformedPresentMatch
+		}
+
+		transformedRangeMatch, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRangeMatch(original["range_match"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedRangeMatch); val.IsValid() && !isEmptyValue(val) {
+			transformed["rangeMatch"] = transformedRangeMatch
+		}
+
+		transformedRegexMatch, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRegexMatch(original["regex_match"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedRegexMatch); val.IsValid() && !isEmptyValue(val) {
+			transformed["regexMatch"] = transformedRegexMatch
+		}
+
+		transformedSuffixMatch, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesSuffixMatch(original["suffix_match"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedSuffixMatch); val.IsValid() && !isEmptyValue(val) {
+			transformed["suffixMatch"] = transformedSuffixMatch
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesExactMatch(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesHeaderName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesInvertMatch(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesPrefixMatch(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesPresentMatch(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRangeMatch(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedRangeEnd, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRangeMatchRangeEnd(original["range_end"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRangeEnd); val.IsValid() && !isEmptyValue(val) {
+		transformed["rangeEnd"] = transformedRangeEnd
+	}
+
+	transformedRangeStart, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRangeMatchRangeStart(original["range_start"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRangeStart); val.IsValid() && !isEmptyValue(val) {
+		transformed["rangeStart"] = transformedRangeStart
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRangeMatchRangeEnd(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRangeMatchRangeStart(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesRegexMatch(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesHeaderMatchesSuffixMatch(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesIgnoreCase(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesPrefixMatch(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatches(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedExactMatch, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesExactMatch(original["exact_match"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedExactMatch); val.IsValid() && !isEmptyValue(val) {
+			transformed["exactMatch"] = transformedExactMatch
+		}
+
+		transformedName, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesName(original["name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+			transformed["name"] = transformedName
+		}
+
+		transformedPresentMatch, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesPresentMatch(original["present_match"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPresentMatch); val.IsValid() && !isEmptyValue(val) {
+			transformed["presentMatch"] = transformedPresentMatch
+		}
+
+		transformedRegexMatch, err := expandComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesRegexMatch(original["regex_match"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedRegexMatch); val.IsValid() && !isEmptyValue(val) {
+			transformed["regexMatch"] = transformedRegexMatch
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesExactMatch(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesPresentMatch(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesQueryParameterMatchesRegexMatch(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesMatchRulesRegexMatch(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesPriority(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteAction(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedFaultInjectionPolicy, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicy(original["fault_injection_policy"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedFaultInjectionPolicy); val.IsValid() && !isEmptyValue(val) {
+		transformed["faultInjectionPolicy"] = transformedFaultInjectionPolicy
+	}
+
+	transformedRequestMirrorPolicy, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionRequestMirrorPolicy(original["request_mirror_policy"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRequestMirrorPolicy); val.IsValid() && !isEmptyValue(val) {
+		transformed["requestMirrorPolicy"] = transformedRequestMirrorPolicy
+	}
+
+	transformedRetryPolicy, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicy(original["retry_policy"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRetryPolicy); val.IsValid() && !isEmptyValue(val) {
+		transformed["retryPolicy"] = transformedRetryPolicy
+	}
+
+	transformedTimeout, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionTimeout(original["timeout"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedTimeout); val.IsValid() && !isEmptyValue(val) {
+		transformed["timeout"] = transformedTimeout
+	}
+
+	transformedUrlRewrite, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionUrlRewrite(original["url_rewrite"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedUrlRewrite); val.IsValid() && !isEmptyValue(val) {
+		transformed["urlRewrite"] = transformedUrlRewrite
+	}
+
+	transformedWeightedBackendServices, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServices(original["weighted_backend_services"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedWeightedBackendServices); val.IsValid() && !isEmptyValue(val) {
+		transformed["weightedBackendServices"] = transformedWeightedBackendServices
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicy(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedAbort, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyAbort(original["abort"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAbort); val.IsValid() && !isEmptyValue(val) {
+		transformed["abort"] = transformedAbort
+	}
+
+	transformedDelay, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelay(original["delay"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDelay); val.IsValid() && !isEmptyValue(val) {
+		transformed["delay"] = transformedDelay
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyAbort(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedHttpStatus, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyAbortHttpStatus(original["http_status"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedHttpStatus); val.IsValid() && !isEmptyValue(val) {
+		transformed["httpStatus"] = transformedHttpStatus
+	}
+
+	transformedPercentage, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyAbortPercentage(original["percentage"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPercentage); val.IsValid() && !isEmptyValue(val) {
+		transformed["percentage"] = transformedPercentage
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyAbortHttpStatus(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyAbortPercentage(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelay(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedFixedDelay, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayFixedDelay(original["fixed_delay"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedFixedDelay); val.IsValid() && !isEmptyValue(val) {
+		transformed["fixedDelay"] = transformedFixedDelay
+	}
+
+	transformedPercentage, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayPercentage(original["percentage"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPercentage); val.IsValid() && !isEmptyValue(val) {
+		transformed["percentage"] = transformedPercentage
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayFixedDelay(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedNanos, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayFixedDelayNanos(original["nanos"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNanos); val.IsValid() && !isEmptyValue(val) {
+		transformed["nanos"] = transformedNanos
+	}
+
+	transformedSeconds, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayFixedDelaySeconds(original["seconds"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSeconds); val.IsValid() && !isEmptyValue(val) {
+		transformed["seconds"] = transformedSeconds
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayFixedDelayNanos(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayFixedDelaySeconds(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionFaultInjectionPolicyDelayPercentage(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionRequestMirrorPolicy(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedBackendService, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionRequestMirrorPolicyBackendService(original["backend_service"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedBackendService); val.IsValid() && !isEmptyValue(val) {
+		transformed["backendService"] = transformedBackendService
+	}
+
+	return transformed, nil
+}
+
+// ResourceRef only supports 1 type and UrlMap has references to a BackendBucket or BackendService. Just read the self_link string
+// instead of extracting the name and making a self_link out of it.
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionRequestMirrorPolicyBackendService(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicy(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedNumRetries, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyNumRetries(original["num_retries"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNumRetries); val.IsValid() && !isEmptyValue(val) {
+		transformed["numRetries"] = transformedNumRetries
+	}
+
+	transformedPerTryTimeout, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyPerTryTimeout(original["per_try_timeout"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPerTryTimeout); val.IsValid() && !isEmptyValue(val) {
+		transformed["perTryTimeout"] = transformedPerTryTimeout
+	}
+
+	transformedRetryConditions, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyRetryConditions(original["retry_conditions"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRetryConditions); val.IsValid() && !isEmptyValue(val) {
+		transformed["retryConditions"] = transformedRetryConditions
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyNumRetries(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyPerTryTimeout(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedNanos, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyPerTryTimeoutNanos(original["nanos"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNanos); val.IsValid() && !isEmptyValue(val) {
+		transformed["nanos"] = transformedNanos
+	}
+
+	transformedSeconds, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyPerTryTimeoutSeconds(original["seconds"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSeconds); val.IsValid() && !isEmptyValue(val) {
+		transformed["seconds"] = transformedSeconds
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyPerTryTimeoutNanos(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyPerTryTimeoutSeconds(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionRetryPolicyRetryConditions(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionTimeout(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedNanos, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionTimeoutNanos(original["nanos"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNanos); val.IsValid() && !isEmptyValue(val) {
+		transformed["nanos"] = transformedNanos
+	}
+
+	transformedSeconds, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionTimeoutSeconds(original["seconds"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSeconds); val.IsValid() && !isEmptyValue(val) {
+		transformed["seconds"] = transformedSeconds
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionTimeoutNanos(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionTimeoutSeconds(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionUrlRewrite(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedHostRewrite, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionUrlRewriteHostRewrite(original["host_rewrite"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedHostRewrite); val.IsValid() && !isEmptyValue(val) {
+		transformed["hostRewrite"] = transformedHostRewrite
+	}
+
+	transformedPathPrefixRewrite, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionUrlRewritePathPrefixRewrite(original["path_prefix_rewrite"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPathPrefixRewrite); val.IsValid() && !isEmptyValue(val) {
+		transformed["pathPrefixRewrite"] = transformedPathPrefixRewrite
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionUrlRewriteHostRewrite(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionUrlRewritePathPrefixRewrite(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServices(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
 	for _, raw := range l {
 		if raw == nil {
 			continue
@@ -706,32 +3190,107 @@ func expandComputeUrlMapPath_matcher(v interface{}, d *schema.ResourceData, conf
 		original := raw.(map[string]interface{})
 		transformed := make(map[string]interface{})
 
-		transformedDefaultService, err := expandComputeUrlMapPath_matcherDefaultService(original["default_service"], d, config)
+		transformedBackendService, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesBackendService(original["backend_service"], d, config)
 		if err != nil {
 			return nil, err
-		} else if val := reflect.ValueOf(transformedDefaultService); val.IsValid() && !isEmptyValue(val) {
-			transformed["defaultService"] = transformedDefaultService
+		} else if val := reflect.ValueOf(transformedBackendService); val.IsValid() && !isEmptyValue(val) {
+			transformed["backendService"] = transformedBackendService
 		}
 
-		transformedDescription, err := expandComputeUrlMapPath_matcherDescription(original["description"], d, config)
+		transformedHeaderAction, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderAction(original["header_action"], d, config)
 		if err != nil {
 			return nil, err
-		} else if val := reflect.ValueOf(transformedDescription); val.IsValid() && !isEmptyValue(val) {
-			transformed["description"] = transformedDescription
+		} else if val := reflect.ValueOf(transformedHeaderAction); val.IsValid() && !isEmptyValue(val) {
+			transformed["headerAction"] = transformedHeaderAction
 		}
 
-		transformedName, err := expandComputeUrlMapPath_matcherName(original["name"], d, config)
+		transformedWeight, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesWeight(original["weight"], d, config)
 		if err != nil {
 			return nil, err
-		} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
-			transformed["name"] = transformedName
+		} else if val := reflect.ValueOf(transformedWeight); val.IsValid() && !isEmptyValue(val) {
+			transformed["weight"] = transformedWeight
 		}
 
-		transformedPath_rule, err := expandComputeUrlMapPath_matcherPath_rule(original["path_rule"], d, config)
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+// ResourceRef only supports 1 type and UrlMap has references to a BackendBucket or BackendService. Just read the self_link string
+// instead of extracting the name and making a self_link out of it.
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesBackendService(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderAction(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedRequestHeadersToAdd, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAdd(original["request_headers_to_add"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRequestHeadersToAdd); val.IsValid() && !isEmptyValue(val) {
+		transformed["requestHeadersToAdd"] = transformedRequestHeadersToAdd
+	}
+
+	transformedRequestHeadersToRemove, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToRemove(original["request_headers_to_remove"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRequestHeadersToRemove); val.IsValid() && !isEmptyValue(val) {
+		transformed["requestHeadersToRemove"] = transformedRequestHeadersToRemove
+	}
+
+	transformedResponseHeadersToAdd, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAdd(original["response_headers_to_add"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedResponseHeadersToAdd); val.IsValid() && !isEmptyValue(val) {
+		transformed["responseHeadersToAdd"] = transformedResponseHeadersToAdd
+	}
+
+	transformedResponseHeadersToRemove, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToRemove(original["response_headers_to_remove"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedResponseHeadersToRemove); val.IsValid() && !isEmptyValue(val) {
+		transformed["responseHeadersToRemove"] = transformedResponseHeadersToRemove
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAdd(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedHeaderName, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAddHeaderName(original["header_name"], d, config)
 		if err != nil {
 			return nil, err
-		} else if val := reflect.ValueOf(transformedPath_rule); val.IsValid() && !isEmptyValue(val) {
-			transformed["pathRules"] = transformedPath_rule
+		} else if val := reflect.ValueOf(transformedHeaderName); val.IsValid() && !isEmptyValue(val) {
+			transformed["headerName"] = transformedHeaderName
+		}
+
+		transformedHeaderValue, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAddHeaderValue(original["header_value"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedHeaderValue); val.IsValid() && !isEmptyValue(val) {
+			transformed["headerValue"] = transformedHeaderValue
+		}
+
+		transformedReplace, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAddReplace(original["replace"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedReplace); val.IsValid() && !isEmptyValue(val) {
+			transformed["replace"] = transformedReplace
 		}
 
 		req = append(req, transformed)
@@ -739,21 +3298,23 @@ func expandComputeUrlMapPath_matcher(v interface{}, d *schema.ResourceData, conf
 	return req, nil
 }
 
-// ResourceRef only supports 1 type and UrlMap has references to a BackendBucket or BackendService. Just read the self_link string
-// instead of extracting the name and making a self_link out of it.
-func expandComputeUrlMapPath_matcherDefaultService(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAddHeaderName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
 
-func expandComputeUrlMapPath_matcherDescription(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAddHeaderValue(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
 
-func expandComputeUrlMapPath_matcherName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToAddReplace(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
 
-func expandComputeUrlMapPath_matcherPath_rule(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionRequestHeadersToRemove(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAdd(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
 	for _, raw := range l {
@@ -763,18 +3324,25 @@ func expandComputeUrlMapPath_matcherPath_rule(v interface{}, d *schema.ResourceD
 		original := raw.(map[string]interface{})
 		transformed := make(map[string]interface{})
 
-		transformedPaths, err := expandComputeUrlMapPath_matcherPath_rulePaths(original["paths"], d, config)
+		transformedHeaderName, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAddHeaderName(original["header_name"], d, config)
 		if err != nil {
 			return nil, err
-		} else if val := reflect.ValueOf(transformedPaths); val.IsValid() && !isEmptyValue(val) {
-			transformed["paths"] = transformedPaths
+		} else if val := reflect.ValueOf(transformedHeaderName); val.IsValid() && !isEmptyValue(val) {
+			transformed["headerName"] = transformedHeaderName
 		}
 
-		transformedService, err := expandComputeUrlMapPath_matcherPath_ruleService(original["service"], d, config)
+		transformedHeaderValue, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAddHeaderValue(original["header_value"], d, config)
 		if err != nil {
 			return nil, err
-		} else if val := reflect.ValueOf(transformedService); val.IsValid() && !isEmptyValue(val) {
-			transformed["service"] = transformedService
+		} else if val := reflect.ValueOf(transformedHeaderValue); val.IsValid() && !isEmptyValue(val) {
+			transformed["headerValue"] = transformedHeaderValue
+		}
+
+		transformedReplace, err := expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAddReplace(original["replace"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedReplace); val.IsValid() && !isEmptyValue(val) {
+			transformed["replace"] = transformedReplace
 		}
 
 		req = append(req, transformed)
@@ -782,14 +3350,107 @@ func expandComputeUrlMapPath_matcherPath_rule(v interface{}, d *schema.ResourceD
 	return req, nil
 }
 
-func expandComputeUrlMapPath_matcherPath_rulePaths(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
-	v = v.(*schema.Set).List()
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAddHeaderName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAddHeaderValue(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToAddReplace(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesHeaderActionResponseHeadersToRemove(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesRouteActionWeightedBackendServicesWeight(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
 
 // ResourceRef only supports 1 type and UrlMap has references to a BackendBucket or BackendService. Just read the self_link string
 // instead of extracting the name and making a self_link out of it.
-func expandComputeUrlMapPath_matcherPath_ruleService(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+func expandComputeUrlMapPath_matcherRouteRulesService(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesUrlRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedHostRedirect, err := expandComputeUrlMapPath_matcherRouteRulesUrlRedirectHostRedirect(original["host_redirect"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedHostRedirect); val.IsValid() && !isEmptyValue(val) {
+		transformed["hostRedirect"] = transformedHostRedirect
+	}
+
+	transformedHttpsRedirect, err := expandComputeUrlMapPath_matcherRouteRulesUrlRedirectHttpsRedirect(original["https_redirect"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedHttpsRedirect); val.IsValid() && !isEmptyValue(val) {
+		transformed["httpsRedirect"] = transformedHttpsRedirect
+	}
+
+	transformedPathRedirect, err := expandComputeUrlMapPath_matcherRouteRulesUrlRedirectPathRedirect(original["path_redirect"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPathRedirect); val.IsValid() && !isEmptyValue(val) {
+		transformed["pathRedirect"] = transformedPathRedirect
+	}
+
+	transformedPrefixRedirect, err := expandComputeUrlMapPath_matcherRouteRulesUrlRedirectPrefixRedirect(original["prefix_redirect"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPrefixRedirect); val.IsValid() && !isEmptyValue(val) {
+		transformed["prefixRedirect"] = transformedPrefixRedirect
+	}
+
+	transformedRedirectResponseCode, err := expandComputeUrlMapPath_matcherRouteRulesUrlRedirectRedirectResponseCode(original["redirect_response_code"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRedirectResponseCode); val.IsValid() && !isEmptyValue(val) {
+		transformed["redirectResponseCode"] = transformedRedirectResponseCode
+	}
+
+	transformedStripQuery, err := expandComputeUrlMapPath_matcherRouteRulesUrlRedirectStripQuery(original["strip_query"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedStripQuery); val.IsValid() && !isEmptyValue(val) {
+		transformed["stripQuery"] = transformedStripQuery
+	}
+
+	return transformed, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesUrlRedirectHostRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesUrlRedirectHttpsRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesUrlRedirectPathRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesUrlRedirectPrefixRedirect(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesUrlRedirectRedirectResponseCode(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeUrlMapPath_matcherRouteRulesUrlRedirectStripQuery(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
 
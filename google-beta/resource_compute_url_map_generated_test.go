@@ -122,6 +122,135 @@ resource "google_storage_bucket" "static" {
 	)
 }
 
+func TestAccComputeUrlMap_urlMapTrafficManagementExample(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeUrlMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeUrlMap_urlMapTrafficManagementExample(acctest.RandString(10)),
+			},
+			{
+				ResourceName:      "google_compute_url_map.urlmap",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeUrlMap_urlMapTrafficManagementExample(val string) string {
+	return fmt.Sprintf(`
+resource "google_compute_url_map" "urlmap" {
+  name        = "urlmap-%s"
+  description = "a description"
+
+  default_service = "${google_compute_backend_service.home.self_link}"
+
+  host_rule {
+    hosts        = ["mysite.com"]
+    path_matcher = "allpaths"
+  }
+
+  host_rule {
+    hosts        = ["old.mysite.com"]
+    path_matcher = "moved"
+  }
+
+  path_matcher {
+    name            = "allpaths"
+    default_service = "${google_compute_backend_service.home.self_link}"
+
+    route_rules {
+      priority = 1
+      service  = "${google_compute_backend_service.canary.self_link}"
+      match_rules {
+        prefix_match = "/"
+        header_matches {
+          header_name = "x-canary"
+          exact_match = "true"
+        }
+      }
+    }
+
+    route_rules {
+      priority = 2
+      match_rules {
+        prefix_match = "/"
+      }
+      route_action {
+        weighted_backend_services {
+          backend_service = "${google_compute_backend_service.home.self_link}"
+          weight          = 90
+        }
+        weighted_backend_services {
+          backend_service = "${google_compute_backend_service.canary.self_link}"
+          weight          = 10
+          header_action {
+            response_headers_to_add {
+              header_name  = "x-served-by"
+              header_value = "canary"
+              replace      = true
+            }
+          }
+        }
+        timeout {
+          seconds = "30"
+        }
+        retry_policy {
+          num_retries      = 3
+          retry_conditions = ["5xx", "gateway-error"]
+          per_try_timeout {
+            seconds = "10"
+          }
+        }
+      }
+    }
+  }
+
+  path_matcher {
+    name = "moved"
+
+    default_url_redirect {
+      host_redirect          = "mysite.com"
+      https_redirect         = true
+      redirect_response_code = "MOVED_PERMANENTLY_DEFAULT"
+      strip_query            = false
+    }
+  }
+}
+
+resource "google_compute_backend_service" "home" {
+  name        = "home-%s"
+  port_name   = "http"
+  protocol    = "HTTP"
+  timeout_sec = 10
+
+  health_checks = ["${google_compute_http_health_check.default.self_link}"]
+}
+
+resource "google_compute_backend_service" "canary" {
+  name        = "canary-%s"
+  port_name   = "http"
+  protocol    = "HTTP"
+  timeout_sec = 10
+
+  health_checks = ["${google_compute_http_health_check.default.self_link}"]
+}
+
+resource "google_compute_http_health_check" "default" {
+  name               = "health-check-%s"
+  request_path       = "/"
+  check_interval_sec = 1
+  timeout_sec        = 1
+}
+`, val, val, val, val,
+	)
+}
+
 func testAccCheckComputeUrlMapDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "google_compute_url_map" {
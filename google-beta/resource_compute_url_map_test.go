@@ -2,13 +2,138 @@ package google
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_url_map", testSweeper{
+		Dependencies: []string{"gcp_compute_target_http_proxy", "gcp_compute_target_https_proxy"},
+		List:         listGlobalComputeResources("urlMaps"),
+		Delete:       sweepComputeResource,
+	})
+}
+
+func TestComputeUrlMapDefaultsCustomizeDiff(t *testing.T) {
+	redirect := []interface{}{
+		map[string]interface{}{
+			"https_redirect": true,
+			"strip_query":    false,
+		},
+	}
+
+	cases := map[string]struct {
+		Config        map[string]interface{}
+		ExpectedError string
+	}{
+		"default service": {
+			Config: map[string]interface{}{
+				"name":            "foo",
+				"default_service": "bar",
+			},
+		},
+		"default redirect": {
+			Config: map[string]interface{}{
+				"name":                 "foo",
+				"default_url_redirect": redirect,
+			},
+		},
+		"neither": {
+			Config: map[string]interface{}{
+				"name": "foo",
+			},
+			ExpectedError: "url_map must set one of",
+		},
+		"both": {
+			Config: map[string]interface{}{
+				"name":                 "foo",
+				"default_service":      "bar",
+				"default_url_redirect": redirect,
+			},
+			ExpectedError: "url_map can only set one of",
+		},
+		"path matcher with default service": {
+			Config: map[string]interface{}{
+				"name":            "foo",
+				"default_service": "bar",
+				"path_matcher": []interface{}{
+					map[string]interface{}{
+						"name":            "baz",
+						"default_service": "bar",
+					},
+				},
+			},
+		},
+		"path matcher with default redirect": {
+			Config: map[string]interface{}{
+				"name":            "foo",
+				"default_service": "bar",
+				"path_matcher": []interface{}{
+					map[string]interface{}{
+						"name":                 "baz",
+						"default_url_redirect": redirect,
+					},
+				},
+			},
+		},
+		"path matcher with neither": {
+			Config: map[string]interface{}{
+				"name":            "foo",
+				"default_service": "bar",
+				"path_matcher": []interface{}{
+					map[string]interface{}{
+						"name": "baz",
+					},
+				},
+			},
+			ExpectedError: `path_matcher "baz" must set one of`,
+		},
+		"path matcher with both": {
+			Config: map[string]interface{}{
+				"name":            "foo",
+				"default_service": "bar",
+				"path_matcher": []interface{}{
+					map[string]interface{}{
+						"name":                 "baz",
+						"default_service":      "bar",
+						"default_url_redirect": redirect,
+					},
+				},
+			},
+			ExpectedError: `path_matcher "baz" can only set one of`,
+		},
+		"unknown default service": {
+			Config: map[string]interface{}{
+				"name":            "foo",
+				"default_service": "${google_compute_backend_service.foo.self_link}",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		raw, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("bad: %s, unexpected error: %s", tn, err)
+		}
+
+		_, err = resourceComputeUrlMap().Diff(nil, terraform.NewResourceConfig(raw), nil)
+		if tc.ExpectedError == "" {
+			if err != nil {
+				t.Errorf("bad: %s, unexpected error: %s", tn, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+			t.Errorf("bad: %s, expected an error containing %q, got %v", tn, tc.ExpectedError, err)
+		}
+	}
+}
+
 func TestAccComputeUrlMap_basic(t *testing.T) {
 	t.Parallel()
 
@@ -123,6 +248,48 @@ func TestAccComputeUrlMap_noPathRulesWithUpdate(t *testing.T) {
 	})
 }
 
+func TestAccComputeUrlMap_trafficManagementUpdate(t *testing.T) {
+	t.Parallel()
+
+	randString := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeUrlMapDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeUrlMap_trafficManagement1(randString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeUrlMapExists("google_compute_url_map.foobar"),
+					resource.TestCheckResourceAttr("google_compute_url_map.foobar", "path_matcher.0.route_rules.1.route_action.0.weighted_backend_services.0.weight", "90"),
+					resource.TestCheckResourceAttr("google_compute_url_map.foobar", "path_matcher.1.default_url_redirect.0.host_redirect", "mysite.com"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_url_map.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccComputeUrlMap_trafficManagement2(randString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeUrlMapExists("google_compute_url_map.foobar"),
+					resource.TestCheckResourceAttr("google_compute_url_map.foobar", "path_matcher.0.route_rules.1.route_action.0.weighted_backend_services.0.weight", "50"),
+					resource.TestCheckResourceAttr("google_compute_url_map.foobar", "path_matcher.0.route_rules.1.route_action.0.fault_injection_policy.0.abort.0.http_status", "503"),
+					resource.TestCheckResourceAttr("google_compute_url_map.foobar", "path_matcher.0.route_rules.2.url_redirect.0.path_redirect", "/"),
+					resource.TestCheckResourceAttr("google_compute_url_map.foobar", "path_matcher.1.default_url_redirect.0.host_redirect", "www.mysite.com"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_url_map.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeUrlMapExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -391,3 +558,224 @@ resource "google_compute_url_map" "foobar" {
 }
 `, bsName, hcName, umName)
 }
+
+func testAccComputeUrlMap_trafficManagement1(randString string) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_service" "home" {
+	name          = "urlmap-test-home-%s"
+	health_checks = ["${google_compute_http_health_check.zero.self_link}"]
+}
+
+resource "google_compute_backend_service" "canary" {
+	name          = "urlmap-test-canary-%s"
+	health_checks = ["${google_compute_http_health_check.zero.self_link}"]
+}
+
+resource "google_compute_http_health_check" "zero" {
+	name               = "urlmap-test-%s"
+	request_path       = "/"
+	check_interval_sec = 1
+	timeout_sec        = 1
+}
+
+resource "google_compute_url_map" "foobar" {
+	name            = "urlmap-test-%s"
+	default_service = "${google_compute_backend_service.home.self_link}"
+
+	host_rule {
+		hosts        = ["mysite.com"]
+		path_matcher = "allpaths"
+	}
+
+	host_rule {
+		hosts        = ["old.mysite.com"]
+		path_matcher = "moved"
+	}
+
+	path_matcher {
+		name            = "allpaths"
+		default_service = "${google_compute_backend_service.home.self_link}"
+
+		route_rules {
+			priority = 1
+			service  = "${google_compute_backend_service.canary.self_link}"
+			match_rules {
+				prefix_match = "/"
+				header_matches {
+					header_name = "x-canary"
+					exact_match = "true"
+				}
+			}
+		}
+
+		route_rules {
+			priority = 2
+			match_rules {
+				prefix_match = "/"
+			}
+			route_action {
+				weighted_backend_services {
+					backend_service = "${google_compute_backend_service.home.self_link}"
+					weight          = 90
+				}
+				weighted_backend_services {
+					backend_service = "${google_compute_backend_service.canary.self_link}"
+					weight          = 10
+				}
+			}
+		}
+	}
+
+	path_matcher {
+		name = "moved"
+
+		default_url_redirect {
+			host_redirect          = "mysite.com"
+			https_redirect         = true
+			redirect_response_code = "MOVED_PERMANENTLY_DEFAULT"
+			strip_query            = false
+		}
+	}
+}
+`, randString, randString, randString, randString)
+}
+
+func testAccComputeUrlMap_trafficManagement2(randString string) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_service" "home" {
+	name          = "urlmap-test-home-%s"
+	health_checks = ["${google_compute_http_health_check.zero.self_link}"]
+}
+
+resource "google_compute_backend_service" "canary" {
+	name          = "urlmap-test-canary-%s"
+	health_checks = ["${google_compute_http_health_check.zero.self_link}"]
+}
+
+resource "google_compute_http_health_check" "zero" {
+	name               = "urlmap-test-%s"
+	request_path       = "/"
+	check_interval_sec = 1
+	timeout_sec        = 1
+}
+
+resource "google_compute_url_map" "foobar" {
+	name            = "urlmap-test-%s"
+	default_service = "${google_compute_backend_service.home.self_link}"
+
+	host_rule {
+		hosts        = ["mysite.com"]
+		path_matcher = "allpaths"
+	}
+
+	host_rule {
+		hosts        = ["old.mysite.com"]
+		path_matcher = "moved"
+	}
+
+	path_matcher {
+		name            = "allpaths"
+		default_service = "${google_compute_backend_service.home.self_link}"
+
+		route_rules {
+			priority = 1
+			service  = "${google_compute_backend_service.canary.self_link}"
+			match_rules {
+				prefix_match = "/"
+				header_matches {
+					header_name = "x-user-bucket"
+					range_match {
+						range_start = 0
+						range_end   = 10
+					}
+				}
+				query_parameter_matches {
+					name        = "canary"
+					exact_match = "true"
+				}
+			}
+			header_action {
+				request_headers_to_add {
+					header_name  = "x-canary"
+					header_value = "true"
+					replace      = true
+				}
+				response_headers_to_remove = ["x-internal"]
+			}
+		}
+
+		route_rules {
+			priority = 2
+			match_rules {
+				prefix_match = "/"
+			}
+			route_action {
+				weighted_backend_services {
+					backend_service = "${google_compute_backend_service.home.self_link}"
+					weight          = 50
+				}
+				weighted_backend_services {
+					backend_service = "${google_compute_backend_service.canary.self_link}"
+					weight          = 50
+					header_action {
+						response_headers_to_add {
+							header_name  = "x-served-by"
+							header_value = "canary"
+							replace      = true
+						}
+					}
+				}
+				fault_injection_policy {
+					abort {
+						http_status = 503
+						percentage  = 1.0
+					}
+					delay {
+						percentage = 5.0
+						fixed_delay {
+							seconds = "1"
+							nanos   = 500
+						}
+					}
+				}
+				request_mirror_policy {
+					backend_service = "${google_compute_backend_service.canary.self_link}"
+				}
+				url_rewrite {
+					host_rewrite        = "backend.mysite.com"
+					path_prefix_rewrite = "/v2/"
+				}
+			}
+		}
+
+		route_rules {
+			priority = 3
+			match_rules {
+				full_path_match = "/legacy"
+				ignore_case     = true
+			}
+			match_rules {
+				regex_match = "/old/.*"
+			}
+			url_redirect {
+				path_redirect          = "/"
+				https_redirect         = true
+				redirect_response_code = "FOUND"
+				strip_query            = true
+			}
+		}
+	}
+
+	path_matcher {
+		name = "moved"
+
+		default_url_redirect {
+			host_redirect          = "www.mysite.com"
+			https_redirect         = true
+			redirect_response_code = "PERMANENT_REDIRECT"
+			strip_query            = true
+		}
+	}
+}
+`, randString, randString, randString, randString)
+}
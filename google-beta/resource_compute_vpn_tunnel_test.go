@@ -72,6 +72,44 @@ func TestAccComputeVpnTunnel_defaultTrafficSelectors(t *testing.T) {
 	})
 }
 
+func TestAccComputeVpnTunnel_haVpnExternalPeer(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeVpnTunnelDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeVpnTunnel_haVpnExternalPeer(suffix),
+			},
+			resource.TestStep{
+				ResourceName:            "google_compute_vpn_tunnel.tunnel0",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"shared_secret"},
+			},
+			resource.TestStep{
+				ResourceName:            "google_compute_vpn_tunnel.tunnel1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"shared_secret"},
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_ha_vpn_gateway.ha_gateway",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_external_vpn_gateway.external_gateway",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccComputeVpnTunnel_basic() string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "foobar" {
@@ -239,3 +277,101 @@ resource "google_compute_vpn_tunnel" "foobar" {
 		acctest.RandString(10), acctest.RandString(10), acctest.RandString(10),
 		acctest.RandString(10))
 }
+
+func testAccComputeVpnTunnel_haVpnExternalPeer(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "network" {
+  name                    = "tunnel-test-%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_ha_vpn_gateway" "ha_gateway" {
+  name    = "tunnel-test-%s"
+  region  = "us-central1"
+  network = "${google_compute_network.network.self_link}"
+}
+
+resource "google_compute_external_vpn_gateway" "external_gateway" {
+  name            = "tunnel-test-%s"
+  redundancy_type = "TWO_IPS_REDUNDANCY"
+
+  interface {
+    id         = 0
+    ip_address = "8.8.8.8"
+  }
+
+  interface {
+    id         = 1
+    ip_address = "8.8.4.4"
+  }
+}
+
+resource "google_compute_router" "router" {
+  name    = "tunnel-test-%s"
+  region  = "us-central1"
+  network = "${google_compute_network.network.name}"
+
+  bgp {
+    asn = 64514
+  }
+}
+
+resource "google_compute_vpn_tunnel" "tunnel0" {
+  name                            = "tunnel-test-%s-0"
+  region                          = "us-central1"
+  vpn_gateway                     = "${google_compute_ha_vpn_gateway.ha_gateway.self_link}"
+  vpn_gateway_interface           = 0
+  peer_external_gateway           = "${google_compute_external_vpn_gateway.external_gateway.self_link}"
+  peer_external_gateway_interface = 0
+  shared_secret                   = "a secret message"
+  router                          = "${google_compute_router.router.self_link}"
+}
+
+resource "google_compute_vpn_tunnel" "tunnel1" {
+  name                            = "tunnel-test-%s-1"
+  region                          = "us-central1"
+  vpn_gateway                     = "${google_compute_ha_vpn_gateway.ha_gateway.self_link}"
+  vpn_gateway_interface           = 1
+  peer_external_gateway           = "${google_compute_external_vpn_gateway.external_gateway.self_link}"
+  peer_external_gateway_interface = 1
+  shared_secret                   = "a secret message"
+  router                          = "${google_compute_router.router.self_link}"
+}
+
+resource "google_compute_router_interface" "interface0" {
+  name       = "tunnel-test-%s-0"
+  router     = "${google_compute_router.router.name}"
+  region     = "us-central1"
+  ip_range   = "169.254.0.1/30"
+  vpn_tunnel = "${google_compute_vpn_tunnel.tunnel0.name}"
+}
+
+resource "google_compute_router_peer" "peer0" {
+  name                      = "tunnel-test-%s-0"
+  router                    = "${google_compute_router.router.name}"
+  region                    = "us-central1"
+  peer_ip_address           = "169.254.0.2"
+  peer_asn                  = 64515
+  advertised_route_priority = 100
+  interface                 = "${google_compute_router_interface.interface0.name}"
+}
+
+resource "google_compute_router_interface" "interface1" {
+  name       = "tunnel-test-%s-1"
+  router     = "${google_compute_router.router.name}"
+  region     = "us-central1"
+  ip_range   = "169.254.1.1/30"
+  vpn_tunnel = "${google_compute_vpn_tunnel.tunnel1.name}"
+}
+
+resource "google_compute_router_peer" "peer1" {
+  name                      = "tunnel-test-%s-1"
+  router                    = "${google_compute_router.router.name}"
+  region                    = "us-central1"
+  peer_ip_address           = "169.254.1.2"
+  peer_asn                  = 64515
+  advertised_route_priority = 100
+  interface                 = "${google_compute_router_interface.interface1.name}"
+}
+`, suffix, suffix, suffix, suffix, suffix, suffix, suffix, suffix, suffix, suffix)
+}
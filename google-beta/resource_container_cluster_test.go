@@ -3,6 +3,7 @@ package google
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 
 	"strconv"
@@ -12,6 +13,13 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_container_cluster", testSweeper{
+		List:   testSweepContainerClusterList,
+		Delete: testSweepContainerClusterDelete,
+	})
+}
+
 func TestAccContainerCluster_basic(t *testing.T) {
 	t.Parallel()
 
@@ -2631,3 +2639,29 @@ resource "google_container_cluster" "with_binary_authorization" {
 }
 `, clusterName, enabled)
 }
+
+// Lists the regional clusters in the sweeper's region and the zonal clusters in its zones.
+func testSweepContainerClusterList(config *Config, region string) ([]string, error) {
+	res, err := config.clientContainer.Projects.Locations.Clusters.List(fmt.Sprintf("projects/%s/locations/-", config.Project)).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0)
+	for _, cluster := range res.Clusters {
+		if cluster.Location == region || getRegionFromZone(cluster.Location) == region {
+			names = append(names, fmt.Sprintf("projects/%s/locations/%s/clusters/%s", config.Project, cluster.Location, cluster.Name))
+		}
+	}
+	return names, nil
+}
+
+func testSweepContainerClusterDelete(config *Config, region, name string) error {
+	op, err := config.clientContainer.Projects.Locations.Clusters.Delete(name).Do()
+	if err != nil {
+		return err
+	}
+
+	location := strings.Split(name, "/")[3]
+	return containerOperationWait(config, op, config.Project, location, "deleting GKE cluster", 30, 3)
+}
@@ -1,13 +1,23 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+
+	"google.golang.org/api/dns/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_dns_managed_zone", testSweeper{
+		List:   testSweepDnsManagedZoneList,
+		Delete: testSweepDnsManagedZoneDelete,
+	})
+}
+
 func TestAccDnsManagedZone_update(t *testing.T) {
 	t.Parallel()
 
@@ -159,3 +169,49 @@ resource "google_dns_managed_zone" "foobar" {
 	project = "%s"
 }`, suffix, suffix, description, project)
 }
+
+func testSweepDnsManagedZoneList(config *Config, region string) ([]string, error) {
+	names := make([]string, 0)
+	err := config.clientDns.ManagedZones.List(config.Project).Pages(context.Background(), func(res *dns.ManagedZonesListResponse) error {
+		for _, zone := range res.ManagedZones {
+			names = append(names, zone.Name)
+		}
+		return nil
+	})
+	return names, err
+}
+
+// A zone can only be deleted once it holds nothing but its NS and SOA records.
+func testSweepDnsManagedZoneDelete(config *Config, region, zone string) error {
+	deletions := make([]*dns.ResourceRecordSet, 0)
+	err := config.clientDns.ResourceRecordSets.List(config.Project, zone).Pages(context.Background(), func(res *dns.ResourceRecordSetsListResponse) error {
+		for _, rrset := range res.Rrsets {
+			if rrset.Type != "NS" && rrset.Type != "SOA" {
+				deletions = append(deletions, rrset)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(deletions) > 0 {
+		chg, err := config.clientDns.Changes.Create(config.Project, zone, &dns.Change{Deletions: deletions}).Do()
+		if err != nil {
+			return err
+		}
+
+		w := &DnsChangeWaiter{
+			Service:     config.clientDns,
+			Change:      chg,
+			Project:     config.Project,
+			ManagedZone: zone,
+		}
+		if _, err := w.Conf().WaitForState(); err != nil {
+			return err
+		}
+	}
+
+	return config.clientDns.ManagedZones.Delete(config.Project, zone).Do()
+}
@@ -38,6 +38,8 @@ func resourceFilestoreInstance() *schema.Resource {
 			State: resourceFilestoreInstanceImport,
 		},
 
+		CustomizeDiff: deletionProtectionCustomizeDiff("google_filestore_instance", resourceFilestoreInstance),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(360 * time.Second),
 			Update: schema.DefaultTimeout(360 * time.Second),
@@ -136,6 +138,11 @@ func resourceFilestoreInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -270,6 +277,10 @@ func resourceFilestoreInstanceRead(d *schema.ResourceData, meta interface{}) err
 func resourceFilestoreInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if onlyDeletionProtectionChanged(d, resourceFilestoreInstance().Schema) {
+		return resourceFilestoreInstanceRead(d, meta)
+	}
+
 	obj := make(map[string]interface{})
 	descriptionProp, err := expandFilestoreInstanceDescription(d.Get("description"), d, config)
 	if err != nil {
@@ -345,6 +356,10 @@ func resourceFilestoreInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 func resourceFilestoreInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if err := checkDeletionProtection(d, "google_filestore_instance"); err != nil {
+		return err
+	}
+
 	url, err := replaceVars(d, config, "https://file.googleapis.com/v1beta1/projects/{{project}}/locations/{{zone}}/instances/{{name}}")
 	if err != nil {
 		return err
@@ -390,6 +405,9 @@ func resourceFilestoreInstanceImport(d *schema.ResourceData, meta interface{}) (
 	}
 	d.SetId(id)
 
+	// deletion_protection is only stored in state
+	d.Set("deletion_protection", false)
+
 	return []*schema.ResourceData{d}, nil
 }
 
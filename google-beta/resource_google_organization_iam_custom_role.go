@@ -19,6 +19,8 @@ func resourceGoogleOrganizationIamCustomRole() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customRolePermissionsCustomizeDiff(organizationCustomRoleParent),
+
 		Schema: map[string]*schema.Schema{
 			"role_id": {
 				Type:     schema.TypeString,
@@ -61,6 +63,13 @@ func resourceGoogleOrganizationIamCustomRole() *schema.Resource {
 	}
 }
 
+func organizationCustomRoleParent(diff *schema.ResourceDiff, config *Config) (string, error) {
+	if !diff.NewValueKnown("org_id") {
+		return "", nil
+	}
+	return "organizations/" + diff.Get("org_id").(string), nil
+}
+
 func resourceGoogleOrganizationIamCustomRoleCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -115,7 +124,11 @@ func resourceGoogleOrganizationIamCustomRoleCreate(d *schema.ResourceData, meta
 func resourceGoogleOrganizationIamCustomRoleRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	role, err := config.clientIAM.Organizations.Roles.Get(d.Id()).Do()
+	var role *iam.Role
+	err := retryNotFoundAfterCreate(d, func() (getErr error) {
+		role, getErr = config.clientIAM.Organizations.Roles.Get(d.Id()).Do()
+		return getErr
+	})
 	if err != nil {
 		return handleNotFoundError(err, d, d.Id())
 	}
@@ -19,6 +19,8 @@ func resourceGoogleProjectIamCustomRole() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customRolePermissionsCustomizeDiff(projectCustomRoleParent),
+
 		Schema: map[string]*schema.Schema{
 			"role_id": {
 				Type:     schema.TypeString,
@@ -61,6 +63,19 @@ func resourceGoogleProjectIamCustomRole() *schema.Resource {
 	}
 }
 
+// Testable permissions are the same for every project, so when the project isn't
+// set (or isn't known yet) the provider's project is checked instead.
+func projectCustomRoleParent(diff *schema.ResourceDiff, config *Config) (string, error) {
+	project := diff.Get("project").(string)
+	if project == "" {
+		project = config.Project
+	}
+	if project == "" {
+		return "", nil
+	}
+	return "projects/" + project, nil
+}
+
 func resourceGoogleProjectIamCustomRoleCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -119,7 +134,11 @@ func resourceGoogleProjectIamCustomRoleRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	role, err := config.clientIAM.Projects.Roles.Get(d.Id()).Do()
+	var role *iam.Role
+	err = retryNotFoundAfterCreate(d, func() (getErr error) {
+		role, getErr = config.clientIAM.Projects.Roles.Get(d.Id()).Do()
+		return getErr
+	})
 	if err != nil {
 		return handleNotFoundError(err, d, d.Id())
 	}
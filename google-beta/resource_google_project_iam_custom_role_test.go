@@ -2,11 +2,13 @@ package google
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/iam/v1"
 )
 
 func TestAccProjectIamCustomRole_basic(t *testing.T) {
@@ -118,6 +120,62 @@ func TestAccProjectIamCustomRole_createAfterDestroy(t *testing.T) {
 	})
 }
 
+func TestAccProjectIamCustomRole_invalidPermissions(t *testing.T) {
+	t.Parallel()
+
+	roleId := "tfIamCustomRole" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGoogleProjectIamCustomRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckGoogleProjectIamCustomRole_invalidPermissions(roleId),
+				ExpectError: regexp.MustCompile("permissions do not exist or cannot be granted on projects/.*: iam.notARealPermission.list"),
+			},
+		},
+	})
+}
+
+func TestValidateCustomRolePermissions(t *testing.T) {
+	testable := []*iam.Permission{
+		{Name: "iam.roles.list"},
+		{Name: "iam.roles.get", CustomRolesSupportLevel: "TESTING"},
+		{Name: "resourcemanager.projects.list", CustomRolesSupportLevel: "NOT_SUPPORTED"},
+	}
+
+	cases := map[string]struct {
+		permissions []string
+		expectError bool
+	}{
+		"supported": {
+			permissions: []string{"iam.roles.list"},
+		},
+		"testing": {
+			permissions: []string{"iam.roles.list", "iam.roles.get"},
+		},
+		"not supported": {
+			permissions: []string{"iam.roles.list", "resourcemanager.projects.list"},
+			expectError: true,
+		},
+		"unknown": {
+			permissions: []string{"iam.roles.list", "iam.roles.frobnicate"},
+			expectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		err := validateCustomRolePermissions("projects/my-project", tc.permissions, testable)
+		if tc.expectError && err == nil {
+			t.Errorf("%s: expected an error, got none", tn)
+		}
+		if !tc.expectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
 func testAccCheckGoogleProjectIamCustomRoleDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -175,3 +233,13 @@ resource "google_project_iam_custom_role" "foo" {
 }
 `, roleId)
 }
+
+func testAccCheckGoogleProjectIamCustomRole_invalidPermissions(roleId string) string {
+	return fmt.Sprintf(`
+resource "google_project_iam_custom_role" "foo" {
+  role_id     = "%s"
+  title       = "My Custom Role"
+  permissions = ["iam.roles.list", "iam.notARealPermission.list"]
+}
+`, roleId)
+}
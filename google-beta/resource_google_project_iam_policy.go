@@ -36,6 +36,11 @@ func resourceGoogleProjectIamPolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"allow_self_lockout": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"authoritative": &schema.Schema{
 				Removed:  "The authoritative field was removed. To ignore changes not managed by Terraform, use google_project_iam_binding and google_project_iam_member instead. See https://www.terraform.io/docs/providers/google/r/google_project_iam.html for more information.",
 				Type:     schema.TypeBool,
@@ -69,6 +74,10 @@ func resourceGoogleProjectIamPolicyCreate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Could not get valid 'policy_data' from resource: %v", err)
 	}
 
+	if err := checkProjectIamPolicySelfLockout(d, config, project, policy); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Setting IAM policy for project %q", project)
 	err = setProjectIamPolicy(policy, config, project)
 	if err != nil {
@@ -114,6 +123,10 @@ func resourceGoogleProjectIamPolicyUpdate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Could not get valid 'policy_data' from resource: %v", err)
 	}
 
+	if err := checkProjectIamPolicySelfLockout(d, config, project, policy); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Updating IAM policy for project %q", project)
 	err = setProjectIamPolicy(policy, config, project)
 	if err != nil {
@@ -139,6 +152,10 @@ func resourceGoogleProjectIamPolicyDelete(d *schema.ResourceData, meta interface
 	}
 
 	ep.Bindings = make([]*cloudresourcemanager.Binding, 0)
+	if err := checkProjectIamPolicySelfLockout(d, config, project, ep); err != nil {
+		return err
+	}
+
 	if err = setProjectIamPolicy(ep, config, project); err != nil {
 		return fmt.Errorf("Error applying IAM policy to project: %v", err)
 	}
@@ -165,6 +182,18 @@ func setProjectIamPolicy(policy *cloudresourcemanager.Policy, config *Config, pi
 	return nil
 }
 
+func checkProjectIamPolicySelfLockout(d *schema.ResourceData, config *Config, project string, policy *cloudresourcemanager.Policy) error {
+	if d.Get("allow_self_lockout").(bool) {
+		return nil
+	}
+
+	updater := &ProjectIamUpdater{
+		resourceId: project,
+		Config:     config,
+	}
+	return checkIamPolicySelfLockout(config, updater, "resourcemanager.projects.setIamPolicy", policy)
+}
+
 // Get a cloudresourcemanager.Policy from a schema.ResourceData
 func getResourceIamPolicy(d *schema.ResourceData) (*cloudresourcemanager.Policy, error) {
 	ps := d.Get("policy_data").(string)
@@ -235,6 +235,48 @@ func TestIamMergeBindings(t *testing.T) {
 	}
 }
 
+func TestIamRolesForMember(t *testing.T) {
+	bindings := []*cloudresourcemanager.Binding{
+		{
+			Role:    "roles/owner",
+			Members: []string{"user:jane@example.com", "serviceAccount:tf@my-project.iam.gserviceaccount.com"},
+		},
+		{
+			Role:    "roles/viewer",
+			Members: []string{"serviceAccount:tf@my-project.iam.gserviceaccount.com"},
+		},
+		{
+			Role:    "roles/owner",
+			Members: []string{"group:admins@example.com"},
+		},
+	}
+
+	cases := map[string]struct {
+		email    string
+		expected []string
+	}{
+		"service account": {
+			email:    "tf@my-project.iam.gserviceaccount.com",
+			expected: []string{"roles/owner", "roles/viewer"},
+		},
+		"user": {
+			email:    "jane@example.com",
+			expected: []string{"roles/owner"},
+		},
+		"unbound": {
+			email:    "john@example.com",
+			expected: []string{},
+		},
+	}
+
+	for tn, tc := range cases {
+		got := rolesForMember(bindings, iamMemberForEmail(tc.email))
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tn, tc.expected, got)
+		}
+	}
+}
+
 func derefBindings(b []*cloudresourcemanager.Binding) []cloudresourcemanager.Binding {
 	db := make([]cloudresourcemanager.Binding, len(b))
 
@@ -17,7 +17,7 @@ func resourceGoogleProjectService() *schema.Resource {
 		Update: resourceGoogleProjectServiceUpdate,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceGoogleProjectServiceImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -37,6 +37,11 @@ func resourceGoogleProjectService() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"disable_dependent_services": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -120,7 +125,7 @@ func resourceGoogleProjectServiceDelete(d *schema.ResourceData, meta interface{}
 		return nil
 	}
 
-	if err = disableService(id.service, id.project, config); err != nil {
+	if err = disableService(id.service, id.project, config, d.Get("disable_dependent_services").(bool)); err != nil {
 		return fmt.Errorf("Error disabling service: %s", err)
 	}
 
@@ -129,12 +134,17 @@ func resourceGoogleProjectServiceDelete(d *schema.ResourceData, meta interface{}
 }
 
 func resourceGoogleProjectServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	// The only thing that can be updated without a ForceNew is whether to disable the service on resource delete.
+	// The only things that can be updated without a ForceNew are how to disable the service on resource delete.
 	// This doesn't require any calls to any APIs since it's all internal state.
 	// This update is a no-op.
 	return nil
 }
 
+func resourceGoogleProjectServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("disable_dependent_services", false)
+	return []*schema.ResourceData{d}, nil
+}
+
 // Parts that make up the id of a `google_project_service` resource.
 // Project is included in order to allow multiple projects to enable the same service within the same Terraform state
 type projectServiceId struct {
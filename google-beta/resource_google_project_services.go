@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/errwrap"
@@ -19,7 +21,7 @@ func resourceGoogleProjectServices() *schema.Resource {
 		Update: resourceGoogleProjectServicesUpdate,
 		Delete: resourceGoogleProjectServicesDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceGoogleProjectServicesImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,6 +45,11 @@ func resourceGoogleProjectServices() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"disable_dependent_services": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -72,7 +79,7 @@ func resourceGoogleProjectServicesCreate(d *schema.ResourceData, meta interface{
 
 	// This call disables any APIs that aren't defined in cfgServices,
 	// and enables all of those that are
-	err = reconcileServices(cfgServices, apiServices, config, pid)
+	err = reconcileServices(cfgServices, apiServices, config, pid, d.Get("disable_dependent_services").(bool))
 	if err != nil {
 		return fmt.Errorf("Error creating services: %v", err)
 	}
@@ -109,7 +116,7 @@ func resourceGoogleProjectServicesUpdate(d *schema.ResourceData, meta interface{
 
 	// This call disables any APIs that aren't defined in cfgServices,
 	// and enables all of those that are
-	err = reconcileServices(cfgServices, apiServices, config, d.Id())
+	err = reconcileServices(cfgServices, apiServices, config, d.Id(), d.Get("disable_dependent_services").(bool))
 	if err != nil {
 		return fmt.Errorf("Error updating services: %v", err)
 	}
@@ -127,18 +134,34 @@ func resourceGoogleProjectServicesDelete(d *schema.ResourceData, meta interface{
 	}
 
 	config := meta.(*Config)
+
+	project, err := config.clientResourceManager.Projects.Get(d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+	if project.LifecycleState == "DELETE_REQUESTED" {
+		log.Printf("[WARN] Removing services for %s from state, the project is deleted", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	services := resourceServices(d)
-	for _, s := range services {
-		disableService(s, d.Id(), config)
+	if err := disableServices(services, d.Id(), config, d.Get("disable_dependent_services").(bool)); err != nil {
+		return fmt.Errorf("Error deleting services: %v", err)
 	}
 	d.SetId("")
 	return nil
 }
 
+func resourceGoogleProjectServicesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("disable_dependent_services", false)
+	return []*schema.ResourceData{d}, nil
+}
+
 // This function ensures that the services enabled for a project exactly match that
 // in a config by disabling any services that are returned by the API but not present
 // in the config
-func reconcileServices(cfgServices, apiServices []string, config *Config, pid string) error {
+func reconcileServices(cfgServices, apiServices []string, config *Config, pid string, disableDependents bool) error {
 	// Helper to convert slice to map
 	m := func(vals []string) map[string]struct{} {
 		sm := make(map[string]struct{})
@@ -151,19 +174,20 @@ func reconcileServices(cfgServices, apiServices []string, config *Config, pid st
 	cfgMap := m(cfgServices)
 	apiMap := m(apiServices)
 
+	var toDisable []string
 	for k, _ := range apiMap {
 		if _, ok := cfgMap[k]; !ok {
 			// The service in the API is not in the config; disable it.
-			err := disableService(k, pid, config)
-			if err != nil {
-				return err
-			}
+			toDisable = append(toDisable, k)
 		} else {
 			// The service exists in the config and the API, so we don't need
 			// to re-enable it
 			delete(cfgMap, k)
 		}
 	}
+	if err := disableServices(toDisable, pid, config, disableDependents); err != nil {
+		return err
+	}
 
 	keys := make([]string, 0, len(cfgMap))
 	for k, _ := range cfgMap {
@@ -334,13 +358,59 @@ func diffStringSlice(wanted, actual []string) []string {
 	return missing
 }
 
-func disableService(s, pid string, config *Config) error {
+// disableServices disables the given services. A service can't be disabled
+// while services that depend on it are enabled, unless disableDependents is
+// set, so services are disabled after any of their dependents that are also
+// being disabled.
+func disableServices(s []string, pid string, config *Config, disableDependents bool) error {
+	pending := golangSetFromStringSlice(s)
+
+	remaining := make([]string, len(s))
+	copy(remaining, s)
+	sort.Strings(remaining)
+
+	for len(remaining) > 0 {
+		var deferred []string
+		var lastErr error
+		for _, service := range remaining {
+			err := disableService(service, pid, config, disableDependents)
+			if derr, ok := err.(*serviceDependentsError); ok && derr.dependentsIn(pending) {
+				// Try again once its dependents are disabled.
+				deferred = append(deferred, service)
+				lastErr = err
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			delete(pending, service)
+		}
+
+		if len(deferred) == len(remaining) {
+			return lastErr
+		}
+		remaining = deferred
+	}
+
+	return nil
+}
+
+func disableService(s, pid string, config *Config, disableDependents bool) error {
 	err := retryTime(func() error {
-		name := fmt.Sprintf("projects/%s/services/%s", pid, s)
-		sop, err := config.clientServiceUsage.Services.Disable(name, &serviceusage.DisableServiceRequest{}).Do()
+		// The vendored client predates disableDependentServices, so the
+		// request is made directly.
+		url := fmt.Sprintf("https://serviceusage.googleapis.com/v1beta1/projects/%s/services/%s:disable", pid, s)
+		res, err := sendRequest(config, "POST", url, map[string]interface{}{
+			"disableDependentServices": disableDependents,
+		})
 		if err != nil {
 			return err
 		}
+
+		sop := &serviceusage.Operation{}
+		if err := Convert(res, sop); err != nil {
+			return err
+		}
 		// Wait for the operation to complete
 		_, waitErr := serviceUsageOperationWait(config, sop, "api to disable")
 		if waitErr != nil {
@@ -349,11 +419,64 @@ func disableService(s, pid string, config *Config) error {
 		return nil
 	}, 10)
 	if err != nil {
+		if dependents := serviceDependentsFromError(err); len(dependents) > 0 {
+			return &serviceDependentsError{
+				Service:    s,
+				Project:    pid,
+				Dependents: dependents,
+			}
+		}
 		return fmt.Errorf("Error disabling service %q for project %q: %v", s, pid, err)
 	}
 	return nil
 }
 
+// serviceDependentsError is returned when a service can't be disabled because
+// services that depend on it are enabled.
+type serviceDependentsError struct {
+	Service    string
+	Project    string
+	Dependents []string
+}
+
+func (e *serviceDependentsError) Error() string {
+	return fmt.Sprintf("Error disabling service %q for project %q: it is required by the enabled service(s) %s. Disable them first, or set disable_dependent_services to true to disable them along with it.", e.Service, e.Project, strings.Join(e.Dependents, ", "))
+}
+
+func (e *serviceDependentsError) dependentsIn(services map[string]struct{}) bool {
+	for _, d := range e.Dependents {
+		if _, ok := services[d]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// The API only lists a service's enabled dependents in the message of the
+// error it returns when the service is disabled without them.
+var serviceDependentsRegexp = regexp.MustCompile(`depended on by the following active service\(s\): ([^;]+);`)
+
+func serviceDependentsFromError(err error) []string {
+	gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	if !ok || gerr == nil {
+		return nil
+	}
+
+	m := serviceDependentsRegexp.FindStringSubmatch(gerr.Message)
+	if m == nil {
+		return nil
+	}
+
+	var dependents []string
+	for _, d := range strings.Split(m[1], ",") {
+		if d = strings.TrimSpace(d); d != "" {
+			dependents = append(dependents, d)
+		}
+	}
+	sort.Strings(dependents)
+	return dependents
+}
+
 func resourceServices(d *schema.ResourceData) []string {
 	// Calculate the tags
 	var services []string
@@ -2,15 +2,19 @@ package google
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/googleapi"
 )
 
 // Test that services can be enabled and disabled on a project
@@ -320,3 +324,62 @@ func testStringsToString(s []string) string {
 	log.Printf("[DEBUG]: Converted list of strings to %s", r)
 	return b.String()
 }
+
+func TestServiceDependentsFromError(t *testing.T) {
+	cases := map[string]struct {
+		Err      error
+		Expected []string
+	}{
+		"dependents": {
+			Err: &googleapi.Error{
+				Code:    400,
+				Message: "The service compute.googleapis.com is depended on by the following active service(s): dataflow.googleapis.com,container.googleapis.com; Please specify disable_dependent_services=true if you still wish to disable the service compute.googleapis.com.",
+			},
+			Expected: []string{"container.googleapis.com", "dataflow.googleapis.com"},
+		},
+		"wrapped": {
+			Err: errwrap.Wrapf("failed: {{err}}", &googleapi.Error{
+				Code:    400,
+				Message: "The service pubsub.googleapis.com is depended on by the following active service(s): cloudfunctions.googleapis.com; Please specify disable_dependent_services=true if you still wish to disable the service pubsub.googleapis.com.",
+			}),
+			Expected: []string{"cloudfunctions.googleapis.com"},
+		},
+		"other error": {
+			Err: &googleapi.Error{
+				Code:    403,
+				Message: "The caller does not have permission",
+			},
+		},
+		"not an API error": {
+			Err: errors.New("depended on by the following active service(s): foo.googleapis.com;"),
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := serviceDependentsFromError(tc.Err); !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("bad: %s, expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestServiceDependentsErrorDependentsIn(t *testing.T) {
+	err := &serviceDependentsError{
+		Service:    "compute.googleapis.com",
+		Project:    "my-project",
+		Dependents: []string{"container.googleapis.com", "dataflow.googleapis.com"},
+	}
+
+	pending := golangSetFromStringSlice([]string{"compute.googleapis.com", "container.googleapis.com", "dataflow.googleapis.com"})
+	if !err.dependentsIn(pending) {
+		t.Errorf("expected the dependents to all be pending")
+	}
+
+	delete(pending, "dataflow.googleapis.com")
+	if err.dependentsIn(pending) {
+		t.Errorf("expected dataflow.googleapis.com to not be pending")
+	}
+
+	if !strings.Contains(err.Error(), "container.googleapis.com, dataflow.googleapis.com") {
+		t.Errorf("expected the error to list the dependents, got %q", err.Error())
+	}
+}
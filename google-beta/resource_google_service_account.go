@@ -87,7 +87,11 @@ func resourceGoogleServiceAccountRead(d *schema.ResourceData, meta interface{})
 	config := meta.(*Config)
 
 	// Confirm the service account exists
-	sa, err := config.clientIAM.Projects.ServiceAccounts.Get(d.Id()).Do()
+	var sa *iam.ServiceAccount
+	err := retryNotFoundAfterCreate(d, func() (getErr error) {
+		sa, getErr = config.clientIAM.Projects.ServiceAccounts.Get(d.Id()).Do()
+		return getErr
+	})
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Service Account %q", d.Id()))
 	}
@@ -1,14 +1,24 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+
+	"google.golang.org/api/iam/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_service_account", testSweeper{
+		List:   testSweepServiceAccountList,
+		Delete: testSweepServiceAccountDelete,
+	})
+}
+
 // Test that a service account resource can be created, updated, and destroyed
 func TestAccServiceAccount_basic(t *testing.T) {
 	t.Parallel()
@@ -126,3 +136,21 @@ data "google_iam_policy" "service_account" {
 }
 `, account, account, account, project)
 }
+
+// Service accounts are listed by their full name, which ends with the account's
+// email, so the account id is what's checked against the sweeper prefixes.
+func testSweepServiceAccountList(config *Config, region string) ([]string, error) {
+	names := make([]string, 0)
+	err := config.clientIAM.Projects.ServiceAccounts.List("projects/"+config.Project).Pages(context.Background(), func(res *iam.ListServiceAccountsResponse) error {
+		for _, sa := range res.Accounts {
+			names = append(names, sa.Name)
+		}
+		return nil
+	})
+	return names, err
+}
+
+func testSweepServiceAccountDelete(config *Config, region, name string) error {
+	_, err := config.clientIAM.Projects.ServiceAccounts.Delete(name).Do()
+	return err
+}
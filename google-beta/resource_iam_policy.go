@@ -23,6 +23,16 @@ var IamPolicyBaseSchema = map[string]*schema.Schema{
 	},
 }
 
+// Added to authoritative policies for resources where the identity Terraform runs
+// as could remove its own ability to manage the policy.
+var IamPolicySelfLockoutSchema = map[string]*schema.Schema{
+	"allow_self_lockout": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+}
+
 func iamPolicyImport(resourceIdParser resourceIdParserFunc) schema.StateFunc {
 	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 		if resourceIdParser == nil {
@@ -56,6 +66,44 @@ func ResourceIamPolicyWithImport(parentSpecificSchema map[string]*schema.Schema,
 	return r
 }
 
+// ResourceIamPolicyWithSelfLockoutProtection returns an authoritative IAM policy resource that refuses
+// to write a policy removing permission from the identity Terraform runs as, unless
+// allow_self_lockout is set.
+func ResourceIamPolicyWithSelfLockoutProtection(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, resourceIdParser resourceIdParserFunc, permission string) *schema.Resource {
+	guardedUpdaterFunc := func(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return nil, err
+		}
+
+		return &selfLockoutGuardedIamUpdater{
+			ResourceIamUpdater: updater,
+			d:                  d,
+			config:             config,
+			permission:         permission,
+		}, nil
+	}
+
+	return ResourceIamPolicyWithImport(mergeSchemas(parentSpecificSchema, IamPolicySelfLockoutSchema), guardedUpdaterFunc, resourceIdParser)
+}
+
+type selfLockoutGuardedIamUpdater struct {
+	ResourceIamUpdater
+	d          *schema.ResourceData
+	config     *Config
+	permission string
+}
+
+func (u *selfLockoutGuardedIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
+	if !u.d.Get("allow_self_lockout").(bool) {
+		if err := checkIamPolicySelfLockout(u.config, u.ResourceIamUpdater, u.permission, policy); err != nil {
+			return err
+		}
+	}
+
+	return u.ResourceIamUpdater.SetResourceIamPolicy(policy)
+}
+
 func ResourceIamPolicyCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		config := meta.(*Config)
@@ -160,7 +160,11 @@ func resourceKmsCryptoKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Executing read for KMS CryptoKey %s", cryptoKeyId.cryptoKeyId())
 
-	cryptoKey, err := config.clientKms.Projects.Locations.KeyRings.CryptoKeys.Get(cryptoKeyId.cryptoKeyId()).Do()
+	var cryptoKey *cloudkms.CryptoKey
+	err = retryNotFoundAfterCreate(d, func() (getErr error) {
+		cryptoKey, getErr = config.clientKms.Projects.Locations.KeyRings.CryptoKeys.Get(cryptoKeyId.cryptoKeyId()).Do()
+		return getErr
+	})
 	if err != nil {
 		return fmt.Errorf("Error reading CryptoKey: %s", err)
 	}
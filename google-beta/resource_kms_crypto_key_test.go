@@ -1,6 +1,7 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -8,8 +9,17 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+
+	"google.golang.org/api/cloudkms/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_kms_crypto_key", testSweeper{
+		List:   testSweepKmsCryptoKeyList,
+		Delete: testSweepKmsCryptoKeyDelete,
+	})
+}
+
 func TestCryptoKeyIdParsing(t *testing.T) {
 	t.Parallel()
 
@@ -359,3 +369,54 @@ resource "google_kms_key_ring" "key_ring" {
 }
 	`, projectId, projectId, projectOrg, projectBillingAccount, keyRingName)
 }
+
+// Crypto keys in test key rings, in the sweeper's region and in the global location.
+func testSweepKmsCryptoKeyList(config *Config, region string) ([]string, error) {
+	names := make([]string, 0)
+	for _, location := range []string{region, "global"} {
+		keyRings := make([]string, 0)
+		parent := fmt.Sprintf("projects/%s/locations/%s", config.Project, location)
+		err := config.clientKms.Projects.Locations.KeyRings.List(parent).Pages(context.Background(), func(res *cloudkms.ListKeyRingsResponse) error {
+			for _, keyRing := range res.KeyRings {
+				if isSweepableTestResource(keyRing.Name) {
+					keyRings = append(keyRings, keyRing.Name)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, keyRing := range keyRings {
+			err := config.clientKms.Projects.Locations.KeyRings.CryptoKeys.List(keyRing).Pages(context.Background(), func(res *cloudkms.ListCryptoKeysResponse) error {
+				for _, key := range res.CryptoKeys {
+					names = append(names, key.Name)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return names, nil
+}
+
+// Crypto keys can't be deleted, so schedule destruction of the versions that
+// are still usable. Versions that are already destroyed or scheduled for
+// destruction are skipped, so keys swept before are cheap to revisit.
+func testSweepKmsCryptoKeyDelete(config *Config, region, name string) error {
+	versionsClient := config.clientKms.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions
+	return versionsClient.List(name).Pages(context.Background(), func(res *cloudkms.ListCryptoKeyVersionsResponse) error {
+		for _, version := range res.CryptoKeyVersions {
+			if version.State != "ENABLED" && version.State != "DISABLED" {
+				continue
+			}
+			if _, err := versionsClient.Destroy(version.Name, &cloudkms.DestroyCryptoKeyVersionRequest{}).Do(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -116,8 +116,11 @@ func resourceKmsKeyRingRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Executing read for KMS KeyRing %s", keyRingId.keyRingId())
 
-	keyRing, err := config.clientKms.Projects.Locations.KeyRings.Get(keyRingId.keyRingId()).Do()
-
+	var keyRing *cloudkms.KeyRing
+	err = retryNotFoundAfterCreate(d, func() (getErr error) {
+		keyRing, getErr = config.clientKms.Projects.Locations.KeyRings.Get(keyRingId.keyRingId()).Do()
+		return getErr
+	})
 	if err != nil {
 		return fmt.Errorf("Error reading KeyRing: %s", err)
 	}
@@ -135,7 +135,11 @@ func resourcePubsubSubscriptionRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	name := d.Id()
-	subscription, err := config.clientPubsub.Projects.Subscriptions.Get(name).Do()
+	var subscription *pubsub.Subscription
+	err = retryNotFoundAfterCreate(d, func() (getErr error) {
+		subscription, getErr = config.clientPubsub.Projects.Subscriptions.Get(name).Do()
+		return getErr
+	})
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Pubsub Subscription %q", name))
 	}
@@ -1,14 +1,24 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+
+	"google.golang.org/api/pubsub/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_pubsub_subscription", testSweeper{
+		List:   testSweepPubsubSubscriptionList,
+		Delete: testSweepPubsubSubscriptionDelete,
+	})
+}
+
 func TestAccPubsubSubscription_basic(t *testing.T) {
 	t.Parallel()
 
@@ -101,3 +111,19 @@ func TestGetComputedTopicName(t *testing.T) {
 		}
 	}
 }
+
+func testSweepPubsubSubscriptionList(config *Config, region string) ([]string, error) {
+	names := make([]string, 0)
+	err := config.clientPubsub.Projects.Subscriptions.List("projects/"+config.Project).Pages(context.Background(), func(res *pubsub.ListSubscriptionsResponse) error {
+		for _, sub := range res.Subscriptions {
+			names = append(names, sub.Name)
+		}
+		return nil
+	})
+	return names, err
+}
+
+func testSweepPubsubSubscriptionDelete(config *Config, region, name string) error {
+	_, err := config.clientPubsub.Projects.Subscriptions.Delete(name).Do()
+	return err
+}
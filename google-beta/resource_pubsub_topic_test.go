@@ -1,14 +1,25 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+
+	"google.golang.org/api/pubsub/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_pubsub_topic", testSweeper{
+		Dependencies: []string{"gcp_pubsub_subscription"},
+		List:         testSweepPubsubTopicList,
+		Delete:       testSweepPubsubTopicDelete,
+	})
+}
+
 func TestAccPubsubTopic_basic(t *testing.T) {
 	t.Parallel()
 
@@ -63,3 +74,19 @@ resource "google_pubsub_topic" "foo" {
 	name = "%s"
 }`, name)
 }
+
+func testSweepPubsubTopicList(config *Config, region string) ([]string, error) {
+	names := make([]string, 0)
+	err := config.clientPubsub.Projects.Topics.List("projects/"+config.Project).Pages(context.Background(), func(res *pubsub.ListTopicsResponse) error {
+		for _, topic := range res.Topics {
+			names = append(names, topic.Name)
+		}
+		return nil
+	})
+	return names, err
+}
+
+func testSweepPubsubTopicDelete(config *Config, region, name string) error {
+	_, err := config.clientPubsub.Projects.Topics.Delete(name).Do()
+	return err
+}
@@ -38,6 +38,8 @@ func resourceRedisInstance() *schema.Resource {
 			State: resourceRedisInstanceImport,
 		},
 
+		CustomizeDiff: deletionProtectionCustomizeDiff("google_redis_instance", resourceRedisInstance),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(360 * time.Second),
 			Update: schema.DefaultTimeout(360 * time.Second),
@@ -134,6 +136,11 @@ func resourceRedisInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -325,6 +332,10 @@ func resourceRedisInstanceRead(d *schema.ResourceData, meta interface{}) error {
 func resourceRedisInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if onlyDeletionProtectionChanged(d, resourceRedisInstance().Schema) {
+		return resourceRedisInstanceRead(d, meta)
+	}
+
 	obj := make(map[string]interface{})
 	displayNameProp, err := expandRedisInstanceDisplayName(d.Get("display_name"), d, config)
 	if err != nil {
@@ -406,6 +417,10 @@ func resourceRedisInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 func resourceRedisInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if err := checkDeletionProtection(d, "google_redis_instance"); err != nil {
+		return err
+	}
+
 	url, err := replaceVars(d, config, "https://redis.googleapis.com/v1beta1/projects/{{project}}/locations/{{region}}/instances/{{name}}")
 	if err != nil {
 		return err
@@ -451,6 +466,9 @@ func resourceRedisInstanceImport(d *schema.ResourceData, meta interface{}) ([]*s
 	}
 	d.SetId(id)
 
+	// deletion_protection is only stored in state
+	d.Set("deletion_protection", false)
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -1,13 +1,23 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+
+	"google.golang.org/api/redis/v1beta1"
 )
 
+func init() {
+	addTestSweeper("gcp_redis_instance", testSweeper{
+		List:   testSweepRedisInstanceList,
+		Delete: testSweepRedisInstanceDelete,
+	})
+}
+
 func TestAccRedisInstance_basic(t *testing.T) {
 	t.Parallel()
 
@@ -163,3 +173,24 @@ resource "google_redis_instance" "test" {
 	}
 }`, network, name)
 }
+
+func testSweepRedisInstanceList(config *Config, region string) ([]string, error) {
+	names := make([]string, 0)
+	parent := fmt.Sprintf("projects/%s/locations/%s", config.Project, region)
+	err := config.clientRedis.Projects.Locations.Instances.List(parent).Pages(context.Background(), func(res *redis.ListInstancesResponse) error {
+		for _, instance := range res.Instances {
+			names = append(names, instance.Name)
+		}
+		return nil
+	})
+	return names, err
+}
+
+func testSweepRedisInstanceDelete(config *Config, region, name string) error {
+	op, err := config.clientRedis.Projects.Locations.Instances.Delete(name).Do()
+	if err != nil {
+		return err
+	}
+
+	return redisOperationWaitTime(config.clientRedis, op, config.Project, "Sweeping Instance", 10)
+}
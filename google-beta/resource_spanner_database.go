@@ -21,11 +21,14 @@ func resourceSpannerDatabase() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSpannerDatabaseCreate,
 		Read:   resourceSpannerDatabaseRead,
+		Update: resourceSpannerDatabaseUpdate,
 		Delete: resourceSpannerDatabaseDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceSpannerDatabaseImport,
 		},
 
+		CustomizeDiff: deletionProtectionCustomizeDiff("google_spanner_database", resourceSpannerDatabase),
+
 		Schema: map[string]*schema.Schema{
 			"instance": &schema.Schema{
 				Type:     schema.TypeString,
@@ -54,6 +57,12 @@ func resourceSpannerDatabase() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -119,9 +128,18 @@ func resourceSpannerDatabaseRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// Only deletion_protection can be updated, and it's only stored in state.
+func resourceSpannerDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceSpannerDatabaseRead(d, meta)
+}
+
 func resourceSpannerDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if err := checkDeletionProtection(d, "google_spanner_database"); err != nil {
+		return err
+	}
+
 	id, err := buildSpannerDatabaseId(d, config)
 	if err != nil {
 		return err
@@ -156,6 +174,9 @@ func resourceSpannerDatabaseImport(d *schema.ResourceData, meta interface{}) ([]
 
 	d.SetId(id.terraformId())
 
+	// deletion_protection is only stored in state
+	d.Set("deletion_protection", false)
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -1,6 +1,7 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -13,8 +14,16 @@ import (
 	"strings"
 
 	"google.golang.org/api/googleapi"
+	"google.golang.org/api/spanner/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_spanner_instance", testSweeper{
+		List:   testSweepSpannerInstanceList,
+		Delete: testSweepSpannerInstanceDelete,
+	})
+}
+
 // Unit Tests
 
 func TestSpannerInstanceId_instanceUri(t *testing.T) {
@@ -299,3 +308,20 @@ resource "google_spanner_instance" "updater" {
 }
 `, name, nodes, extraLabel)
 }
+
+func testSweepSpannerInstanceList(config *Config, region string) ([]string, error) {
+	names := make([]string, 0)
+	err := config.clientSpanner.Projects.Instances.List("projects/"+config.Project).Pages(context.Background(), func(res *spanner.ListInstancesResponse) error {
+		for _, instance := range res.Instances {
+			names = append(names, instance.Name)
+		}
+		return nil
+	})
+	return names, err
+}
+
+// Deleting an instance deletes its databases too.
+func testSweepSpannerInstanceDelete(config *Config, region, name string) error {
+	_, err := config.clientSpanner.Projects.Instances.Delete(name).Do()
+	return err
+}
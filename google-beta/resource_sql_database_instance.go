@@ -52,7 +52,8 @@ func resourceSqlDatabaseInstance() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			customdiff.ForceNewIfChange("settings.0.disk_size", isDiskShrinkage)),
+			customdiff.ForceNewIfChange("settings.0.disk_size", isDiskShrinkage),
+			deletionProtectionCustomizeDiff("google_sql_database_instance", resourceSqlDatabaseInstance)),
 
 		Schema: map[string]*schema.Schema{
 			"region": &schema.Schema{
@@ -312,6 +313,12 @@ func resourceSqlDatabaseInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"deletion_protection": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"replica_configuration": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
@@ -735,6 +742,10 @@ func resourceSqlDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 func resourceSqlDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if onlyDeletionProtectionChanged(d, resourceSqlDatabaseInstance().Schema) {
+		return resourceSqlDatabaseInstanceRead(d, meta)
+	}
+
 	project, err := getProject(d, config)
 	if err != nil {
 		return err
@@ -768,6 +779,10 @@ func resourceSqlDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 func resourceSqlDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if err := checkDeletionProtection(d, "google_sql_database_instance"); err != nil {
+		return err
+	}
+
 	project, err := getProject(d, config)
 	if err != nil {
 		return err
@@ -808,6 +823,9 @@ func resourceSqlDatabaseInstanceImport(d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(id)
 
+	// deletion_protection is only stored in state
+	d.Set("deletion_protection", false)
+
 	return []*schema.ResourceData{d}, nil
 }
 
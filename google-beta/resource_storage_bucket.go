@@ -27,6 +27,8 @@ func resourceStorageBucket() *schema.Resource {
 			State: resourceStorageBucketStateImporter,
 		},
 
+		CustomizeDiff: deletionProtectionCustomizeDiff("google_storage_bucket", resourceStorageBucket),
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -54,6 +56,12 @@ func resourceStorageBucket() *schema.Resource {
 				Default:  false,
 			},
 
+			"deletion_protection": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"labels": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -334,6 +342,10 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if onlyDeletionProtectionChanged(d, resourceStorageBucket().Schema) {
+		return resourceStorageBucketRead(d, meta)
+	}
+
 	sb := &storage.Bucket{}
 
 	if d.HasChange("lifecycle_rule") {
@@ -478,6 +490,10 @@ func resourceStorageBucketRead(d *schema.ResourceData, meta interface{}) error {
 func resourceStorageBucketDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if err := checkDeletionProtection(d, "google_storage_bucket"); err != nil {
+		return err
+	}
+
 	// Get the bucket
 	bucket := d.Get("name").(string)
 
@@ -535,6 +551,7 @@ func resourceStorageBucketDelete(d *schema.ResourceData, meta interface{}) error
 func resourceStorageBucketStateImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("name", d.Id())
 	d.Set("force_destroy", false)
+	d.Set("deletion_protection", false)
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -28,6 +28,12 @@ func TestAccStorageBucketIamBinding(t *testing.T) {
 					fmt.Sprintf("serviceAccount:%s-1@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
 				}),
 			},
+			{
+				ResourceName:      "google_storage_bucket_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/storage.objectViewer", bucket),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				// Test IAM Binding update
 				Config: testAccStorageBucketIamBinding_update(bucket, account),
@@ -66,6 +72,12 @@ func TestAccStorageBucketIamPolicy(t *testing.T) {
 					fmt.Sprintf("serviceAccount:%s-2@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
 				}),
 			},
+			{
+				ResourceName:      "google_storage_bucket_iam_policy.bucket-binding",
+				ImportStateId:     bucket,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -87,6 +99,12 @@ func TestAccStorageBucketIamMember(t *testing.T) {
 					fmt.Sprintf("serviceAccount:%s-1@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
 				}),
 			},
+			{
+				ResourceName:      "google_storage_bucket_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/storage.admin serviceAccount:%s-1@%s.iam.gserviceaccount.com", bucket, account, getTestProjectFromEnv()),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -2,8 +2,10 @@ package google
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -14,6 +16,13 @@ import (
 	"google.golang.org/api/storage/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_storage_bucket", testSweeper{
+		List:   testSweepStorageBucketList,
+		Delete: testSweepStorageBucketDelete,
+	})
+}
+
 func TestAccStorageBucket_basic(t *testing.T) {
 	t.Parallel()
 
@@ -47,6 +56,48 @@ func TestAccStorageBucket_basic(t *testing.T) {
 	})
 }
 
+func TestAccStorageBucket_deletionProtection(t *testing.T) {
+	t.Parallel()
+
+	var bucket storage.Bucket
+	bucketName := fmt.Sprintf("tf-test-protected-bucket-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccStorageBucket_deletionProtection(bucketName, "US", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(
+						"google_storage_bucket.bucket", bucketName, &bucket),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "deletion_protection", "true"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+			resource.TestStep{
+				// Changing the location replaces the bucket
+				Config:      testAccStorageBucket_deletionProtection(bucketName, "EU", true),
+				ExpectError: regexp.MustCompile("deletion_protection is set to true"),
+			},
+			resource.TestStep{
+				Config: testAccStorageBucket_deletionProtection(bucketName, "US", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccStorageBucket_lowercaseLocation(t *testing.T) {
 	t.Parallel()
 
@@ -746,6 +797,16 @@ resource "google_storage_bucket" "bucket" {
 `, bucketName)
 }
 
+func testAccStorageBucket_deletionProtection(bucketName, location string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name                = "%s"
+	location            = "%s"
+	deletion_protection = %t
+}
+`, bucketName, location, deletionProtection)
+}
+
 func testAccStorageBucket_lowercaseLocation(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
@@ -977,3 +1038,31 @@ resource "google_storage_bucket" "bucket" {
 }
 `, bucketName)
 }
+
+func testSweepStorageBucketList(config *Config, region string) ([]string, error) {
+	names := make([]string, 0)
+	err := config.clientStorage.Buckets.List(config.Project).Pages(context.Background(), func(res *storage.Buckets) error {
+		for _, bucket := range res.Items {
+			names = append(names, bucket.Name)
+		}
+		return nil
+	})
+	return names, err
+}
+
+// Buckets have to be emptied, including old object versions, before they can be deleted.
+func testSweepStorageBucketDelete(config *Config, region, bucket string) error {
+	err := config.clientStorage.Objects.List(bucket).Versions(true).Pages(context.Background(), func(res *storage.Objects) error {
+		for _, object := range res.Items {
+			if err := config.clientStorage.Objects.Delete(bucket, object.Name).Generation(object.Generation).Do(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return config.clientStorage.Buckets.Delete(bucket).Do()
+}
@@ -399,6 +399,33 @@ func retryTimeDuration(retryFunc func() error, duration time.Duration) error {
 	})
 }
 
+// Some APIs are eventually consistent, and a Get made right after the resource
+// was created can return a 404. retryNotFoundAfterCreate retries 404s from
+// readFunc for a bounded time if the resource was created in this apply, and
+// otherwise calls readFunc once so that resources deleted out of band are still
+// removed from state. The last error is returned if the window runs out.
+func retryNotFoundAfterCreate(d *schema.ResourceData, readFunc func() error) error {
+	return retryNotFoundAfterCreateDuration(d, readFunc, 2*time.Minute)
+}
+
+func retryNotFoundAfterCreateDuration(d *schema.ResourceData, readFunc func() error, duration time.Duration) error {
+	if !d.IsNewResource() {
+		return readFunc()
+	}
+
+	return resource.Retry(duration, func() *resource.RetryError {
+		err := readFunc()
+		if err == nil {
+			return nil
+		}
+		if isGoogleApiErrorWithCode(err, 404) {
+			log.Printf("[DEBUG] Got a 404 reading %q right after creating it, retrying", d.Id())
+			return resource.RetryableError(err)
+		}
+		return resource.NonRetryableError(err)
+	})
+}
+
 func extractFirstMapConfig(m []interface{}) map[string]interface{} {
 	if len(m) == 0 {
 		return map[string]interface{}{}
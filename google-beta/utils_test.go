@@ -522,3 +522,73 @@ func TestRetryTimeDuration_noretry(t *testing.T) {
 		t.Errorf("expected error function to be called exactly once, but was called %d times", i)
 	}
 }
+
+func TestRetryNotFoundAfterCreate(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.MarkNewResource()
+
+	i := 0
+	f := func() error {
+		i++
+		if i < 3 {
+			return &googleapi.Error{
+				Code: 404,
+			}
+		}
+		return nil
+	}
+	if err := retryNotFoundAfterCreateDuration(d, f, time.Duration(5000)*time.Millisecond); err != nil {
+		t.Errorf("expected 404s to be retried until the read succeeded, got %s", err)
+	}
+	if i != 3 {
+		t.Errorf("expected read function to be called 3 times, but was called %d times", i)
+	}
+}
+
+func TestRetryNotFoundAfterCreate_timeout(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.MarkNewResource()
+
+	f := func() error {
+		return &googleapi.Error{
+			Code: 404,
+		}
+	}
+	err := retryNotFoundAfterCreateDuration(d, f, time.Duration(1000)*time.Millisecond)
+	if !isGoogleApiErrorWithCode(err, 404) {
+		t.Errorf("expected the last 404 to be returned, got %v", err)
+	}
+}
+
+func TestRetryNotFoundAfterCreate_existing(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+
+	i := 0
+	f := func() error {
+		i++
+		return &googleapi.Error{
+			Code: 404,
+		}
+	}
+	retryNotFoundAfterCreateDuration(d, f, time.Duration(1000)*time.Millisecond)
+	if i != 1 {
+		t.Errorf("expected read function to be called exactly once, but was called %d times", i)
+	}
+}
+
+func TestRetryNotFoundAfterCreate_noretry(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.MarkNewResource()
+
+	i := 0
+	f := func() error {
+		i++
+		return &googleapi.Error{
+			Code: 403,
+		}
+	}
+	retryNotFoundAfterCreateDuration(d, f, time.Duration(1000)*time.Millisecond)
+	if i != 1 {
+		t.Errorf("expected read function to be called exactly once, but was called %d times", i)
+	}
+}
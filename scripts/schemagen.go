@@ -1,15 +1,26 @@
-// Generates an initial version of a schema for a new resource type.
+// Generates an initial version of a new resource type: the schema, CRUD
+// functions, expand/flatten helpers, an importer, and a test file with an
+// acceptance test template and a sweeper.
 //
 // This script draws heavily from https://github.com/radeksimko/terraform-gen,
 // but uses GCP's discovery API instead of the struct definition to generate
 // the schemas.
 //
-// This is not meant to be a definitive source of truth for resource schemas,
+// This is not meant to be a definitive source of truth for resources,
 // just a starting point. It has some notable deficiencies, such as:
 // 	* No way to differentiate between fields that are/are not updateable.
 // 	* Required/Optional/Computed are set based on keywords in the description.
+// 	* Long-running operations are left as TODOs, since waiting on them is
+// 	  product-specific.
 //
-// Usage requires credentials. Obtain via gcloud:
+// The discovery document can be read from a local file, which needs neither
+// network access nor credentials:
+//
+//   curl -o redis.json https://www.googleapis.com/discovery/v1/apis/redis/v1beta1/rest
+//   go run ./scripts/schemagen.go -api redis -resource Instance -discovery-file redis.json
+//
+// Otherwise it's fetched from the discovery API, which requires credentials.
+// Obtain via gcloud:
 //
 //   gcloud auth application-default login
 //
@@ -17,17 +28,20 @@
 //
 //   go run ./scripts/schemagen.go -api pubsub -resource Subscription -version v1
 //
-// This will output a file in the directory from which the script is run named `gen_resource_[api]_[resource].go`.
+// This will output `gen_resource_[api]_[resource].go` and `gen_resource_[api]_[resource]_test.go`
+// in the directory given by -output-dir, which defaults to the directory from which the script is run.
 
 package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"go/format"
+	"io/ioutil"
 	"log"
-	"os"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
@@ -43,64 +57,445 @@ func main() {
 	api := flag.String("api", "", "api to query")
 	resource := flag.String("resource", "", "resource to generate")
 	version := flag.String("version", "v1", "api version to query")
+	discoveryFile := flag.String("discovery-file", "", "read the discovery document from this file instead of the discovery API")
+	outputDir := flag.String("output-dir", ".", "directory to write the generated files to")
 	flag.Parse()
 
 	if *api == "" || *resource == "" {
 		flag.PrintDefaults()
-		log.Fatal("usage: go run schemagen.go -api $API -resource $RESOURCE -version $VERSION")
+		log.Fatal("usage: go run schemagen.go -api $API -resource $RESOURCE [-version $VERSION | -discovery-file $FILE]")
+	}
+
+	var doc *discovery.RestDescription
+	var err error
+	if *discoveryFile != "" {
+		doc, err = loadDiscoveryDocument(*discoveryFile)
+	} else {
+		doc, err = fetchDiscoveryDocument(*api, *version)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	data, err := newResourceData(doc, *api, *resource)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fileName := fmt.Sprintf("gen_resource_%s_%s", *api, underscore(*resource))
+	if err := writeTemplate(filepath.Join(*outputDir, fileName+".go"), googleTemplate, data); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeTemplate(filepath.Join(*outputDir, fileName+"_test.go"), testTemplate, data); err != nil {
+		log.Fatal(err)
 	}
+}
 
+func fetchDiscoveryDocument(api, version string) (*discovery.RestDescription, error) {
 	// Discovery API doesn't need authentication
 	client, err := google.DefaultClient(oauth2.NoContext, []string{}...)
 	if err != nil {
-		log.Fatal(fmt.Errorf("Error creating client: %v", err))
+		return nil, fmt.Errorf("Error creating client: %v", err)
 	}
 
 	discoveryService, err := discovery.New(client)
 	if err != nil {
-		log.Fatal(fmt.Errorf("Error creating service: %v", err))
+		return nil, fmt.Errorf("Error creating service: %v", err)
 	}
 
-	resp, err := discoveryService.Apis.GetRest(*api, *version).Fields("schemas").Do()
+	doc, err := discoveryService.Apis.GetRest(api, version).Do()
 	if err != nil {
-		log.Fatal(fmt.Errorf("Error reading API: %v", err))
+		return nil, fmt.Errorf("Error reading API: %v", err)
 	}
+	return doc, nil
+}
 
-	fileName := fmt.Sprintf("gen_resource_%s_%s.go", *api, underscore(*resource))
-	f, err := os.Create(fileName)
-	defer f.Close()
+// loadDiscoveryDocument reads a discovery document saved to disk, e.g. with
+// `curl https://www.googleapis.com/discovery/v1/apis/redis/v1beta1/rest`.
+func loadDiscoveryDocument(path string) (*discovery.RestDescription, error) {
+	b, err := ioutil.ReadFile(path)
 	if err != nil {
-		log.Fatal(err)
+		return nil, fmt.Errorf("Error reading discovery document: %v", err)
 	}
 
-	required, optional, computed := generateFields(resp.Schemas, *resource)
+	doc := &discovery.RestDescription{}
+	if err := json.Unmarshal(b, doc); err != nil {
+		return nil, fmt.Errorf("Error parsing discovery document %q: %v", path, err)
+	}
+	return doc, nil
+}
 
+func writeTemplate(fileName string, tmpl *template.Template, data interface{}) error {
 	buf := &bytes.Buffer{}
-	err = googleTemplate.Execute(buf, struct {
-		TypeName  string
-		ReqFields map[string]string
-		OptFields map[string]string
-		ComFields map[string]string
-	}{
-		// Capitalize the first letter of the api name, then concatenate the resource name onto it.
-		// e.g. compute, instance -> ComputeInstance
-		TypeName:  strings.ToUpper((*api)[0:1]) + (*api)[1:] + *resource,
-		ReqFields: required,
-		OptFields: optional,
-		ComFields: computed,
-	})
-	if err != nil {
-		log.Fatal(err)
+	if err := tmpl.Execute(buf, data); err != nil {
+		return err
 	}
 
 	fmtd, err := format.Source(buf.Bytes())
 	if err != nil {
-		log.Printf("Formatting error: %s", err)
+		// Write the unformatted source so the problem can be inspected.
+		log.Printf("Formatting error in %s: %s", fileName, err)
+		fmtd = buf.Bytes()
 	}
 
-	if _, err := f.Write(fmtd); err != nil {
-		log.Fatal(err)
+	return ioutil.WriteFile(fileName, fmtd, 0644)
+}
+
+// resourceMethods are the REST methods of the collection that holds a resource.
+type resourceMethods struct {
+	Create *discovery.RestMethod
+	Get    *discovery.RestMethod
+	Update *discovery.RestMethod
+	Delete *discovery.RestMethod
+	List   *discovery.RestMethod
+}
+
+// findResourceMethods looks for the collection whose get method returns the
+// given schema, searching nested collections as well.
+func findResourceMethods(resources map[string]discovery.RestResource, schemaName string) *resourceMethods {
+	names := make([]string, 0, len(resources))
+	for k := range resources {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	for _, k := range names {
+		r := resources[k]
+		if get, ok := r.Methods["get"]; ok && get.Response != nil && get.Response.Ref == schemaName {
+			m := &resourceMethods{Get: &get}
+			for _, verbs := range []struct {
+				dst   **discovery.RestMethod
+				names []string
+			}{
+				{&m.Create, []string{"insert", "create"}},
+				{&m.Update, []string{"patch", "update"}},
+				{&m.Delete, []string{"delete"}},
+				{&m.List, []string{"list"}},
+			} {
+				for _, name := range verbs.names {
+					if method, ok := r.Methods[name]; ok {
+						*verbs.dst = &method
+						break
+					}
+				}
+			}
+			return m
+		}
+
+		if m := findResourceMethods(r.Resources, schemaName); m != nil {
+			return m
+		}
+	}
+
+	return nil
+}
+
+var pathParamRegex = regexp.MustCompile(`{(\+?)([^}]+)}`)
+
+// urlTemplate converts a discovery method path into a url template for
+// replaceVars. For methods that act on the resource itself, the last path
+// parameter is the resource name.
+func urlTemplate(doc *discovery.RestDescription, method *discovery.RestMethod, nameLast bool) string {
+	params := pathParamRegex.FindAllStringSubmatch(method.Path, -1)
+	lastParam := ""
+	if len(params) > 0 {
+		lastParam = params[len(params)-1][2]
+	}
+
+	path := pathParamRegex.ReplaceAllStringFunc(method.Path, func(m string) string {
+		parts := pathParamRegex.FindStringSubmatch(m)
+		isLast := nameLast && parts[2] == lastParam
+		if p, ok := method.Parameters[parts[2]]; parts[1] == "+" && ok && p.Pattern != "" {
+			return expandPathPattern(p.Pattern, isLast)
+		}
+		if isLast {
+			return "{{name}}"
+		}
+		return "{{" + paramVariable(parts[2]) + "}}"
+	})
+
+	return baseUrl(doc) + path
+}
+
+func baseUrl(doc *discovery.RestDescription) string {
+	if doc.RootUrl != "" {
+		return doc.RootUrl + doc.ServicePath
+	}
+	return doc.BaseUrl
+}
+
+// expandPathPattern turns a parameter pattern like
+// `^projects/[^/]+/locations/[^/]+$` into `projects/{{project}}/locations/{{location}}`.
+func expandPathPattern(pattern string, nameLast bool) string {
+	// The id pattern contains a slash itself, so swap it out before splitting.
+	pattern = strings.Replace(strings.TrimSuffix(strings.TrimPrefix(pattern, "^"), "$"), "[^/]+", "*", -1)
+	segments := strings.Split(pattern, "/")
+	for i, seg := range segments {
+		if i == 0 || seg != "*" {
+			continue
+		}
+		if nameLast && i == len(segments)-1 {
+			segments[i] = "{{name}}"
+		} else {
+			segments[i] = "{{" + collectionVariable(segments[i-1]) + "}}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// collectionVariable names the variable holding an id within a collection,
+// e.g. the `locations/{id}` part of a path is the resource's location.
+func collectionVariable(collection string) string {
+	return underscore(singular(collection))
+}
+
+func singular(s string) string {
+	switch {
+	case strings.HasSuffix(s, "ies"):
+		return strings.TrimSuffix(s, "ies") + "y"
+	case strings.HasSuffix(s, "sses"), strings.HasSuffix(s, "ches"), strings.HasSuffix(s, "xes"):
+		return strings.TrimSuffix(s, "es")
+	}
+	return strings.TrimSuffix(s, "s")
+}
+
+// paramVariable names the variable for a simple path parameter, e.g. `zone`
+// or `locationsId`.
+func paramVariable(param string) string {
+	if strings.HasSuffix(param, "sId") {
+		return collectionVariable(strings.TrimSuffix(param, "Id"))
+	}
+	return underscore(strings.TrimSuffix(param, "Id"))
+}
+
+// createIdParam returns the query parameter that carries the user-chosen id on
+// create, e.g. `instanceId`, for APIs that don't take the name in the body.
+func createIdParam(method *discovery.RestMethod, schemaName string) string {
+	name := strings.ToLower(schemaName[0:1]) + schemaName[1:] + "Id"
+	if p, ok := method.Parameters[name]; ok && p.Location == "query" {
+		return name
+	}
+	return ""
+}
+
+var templateVarRegex = regexp.MustCompile(`{{([^}]+)}}`)
+
+// idFormat builds the resource id from the variables in its url template,
+// e.g. `{{project}}/{{region}}/{{name}}`.
+func idFormat(url string) string {
+	vars := templateVarRegex.FindAllString(url, -1)
+	return strings.Join(vars, "/")
+}
+
+// importFormats returns the regexes parseImportId accepts for a resource: the
+// full relative path, the short id and the bare name.
+func importFormats(url string) []string {
+	path := url
+	if i := strings.Index(url, "projects/{{project}}"); i >= 0 {
+		path = url[i:]
+	} else if i := strings.Index(url, "{{"); i >= 0 {
+		path = url[i:]
+	}
+
+	toRegex := func(s string) string {
+		return templateVarRegex.ReplaceAllString(s, "(?P<$1>[^/]+)")
+	}
+
+	formats := []string{toRegex(path)}
+	for _, f := range []string{toRegex(idFormat(url)), toRegex("{{name}}")} {
+		if f != formats[len(formats)-1] {
+			formats = append(formats, f)
+		}
+	}
+	return formats
+}
+
+// resourceField describes a property of the API resource, used to generate
+// the expand and flatten functions.
+type resourceField struct {
+	ApiName  string
+	Name     string
+	FuncName string
+	Type     string
+	Computed bool
+	Fields   []resourceField
+}
+
+// IsObject is true for nested objects and arrays of them, which are lists of
+// blocks in the schema.
+func (f resourceField) IsObject() bool {
+	return len(f.Fields) > 0
+}
+
+// GoName is used for local variables in nested expand functions.
+func (f resourceField) GoName() string {
+	return strings.ToUpper(f.ApiName[0:1]) + f.ApiName[1:]
+}
+
+// Limits the depth of nested objects, since some API schemas are recursive.
+const maxNestingDepth = 5
+
+func resourceFields(jsonSchemas map[string]discovery.JsonSchema, ref, funcPrefix string, depth int) []resourceField {
+	props := jsonSchemas[ref].Properties
+	names := make([]string, 0, len(props))
+	for k := range props {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	fields := make([]resourceField, 0, len(names))
+	for _, k := range names {
+		v := props[k]
+		f := resourceField{
+			ApiName:  k,
+			Name:     underscore(k),
+			FuncName: funcPrefix + strings.ToUpper(k[0:1]) + k[1:],
+			Type:     v.Type,
+			Computed: isComputed(v),
+		}
+
+		nestedRef := v.Ref
+		if v.Type == "array" && v.Items != nil {
+			nestedRef = v.Items.Ref
+		}
+		if nestedRef != "" && depth < maxNestingDepth {
+			f.Fields = resourceFields(jsonSchemas, nestedRef, f.FuncName, depth+1)
+		}
+		fields = append(fields, f)
+	}
+
+	return fields
+}
+
+// listField finds the property of a list response that holds the resources.
+func listField(jsonSchemas map[string]discovery.JsonSchema, method *discovery.RestMethod, schemaName string) string {
+	if method == nil || method.Response == nil {
+		return ""
+	}
+	for k, v := range jsonSchemas[method.Response.Ref].Properties {
+		if v.Type == "array" && v.Items != nil && v.Items.Ref == schemaName {
+			return k
+		}
+	}
+	return ""
+}
+
+func returnsOperation(method *discovery.RestMethod) bool {
+	return method != nil && method.Response != nil && strings.HasSuffix(method.Response.Ref, "Operation")
+}
+
+// resourceData is everything needed to render the resource and test templates.
+type resourceData struct {
+	// e.g. RedisInstance
+	TypeName string
+	// e.g. google_redis_instance
+	ResourceName string
+	// e.g. Instance
+	Resource string
+
+	ReqFields map[string]string
+	OptFields map[string]string
+	ComFields map[string]string
+	Fields    []resourceField
+
+	// Fields that only appear in the url, e.g. project or location.
+	UrlFields []string
+
+	CreateUrl  string
+	CreateVerb string
+	ReadUrl    string
+	UpdateUrl  string
+	UpdateVerb string
+	DeleteUrl  string
+	ListUrl    string
+	ListField  string
+
+	CreateOperation bool
+	UpdateOperation bool
+	DeleteOperation bool
+
+	IdFormat      string
+	ImportFormats []string
+}
+
+// UsesStrconv is true if any field needs the fixed64 string conversion.
+func (r *resourceData) UsesStrconv() bool {
+	return anyField(r.Fields, func(f resourceField) bool { return !f.IsObject() && f.Type == "integer" })
+}
+
+// UsesReflect is true if any field is sent to the API.
+func (r *resourceData) UsesReflect() bool {
+	return anyField(r.Fields, func(f resourceField) bool { return !f.Computed })
+}
+
+func anyField(fields []resourceField, pred func(resourceField) bool) bool {
+	for _, f := range fields {
+		if pred(f) || anyField(f.Fields, pred) {
+			return true
+		}
+	}
+	return false
+}
+
+func newResourceData(doc *discovery.RestDescription, api, resource string) (*resourceData, error) {
+	if _, ok := doc.Schemas[resource]; !ok {
+		return nil, fmt.Errorf("Schema %q not found in the %s discovery document", resource, api)
+	}
+
+	methods := findResourceMethods(doc.Resources, resource)
+	if methods == nil || methods.Create == nil || methods.Delete == nil {
+		return nil, fmt.Errorf("Unable to find create, get and delete methods for %q", resource)
+	}
+
+	// Capitalize the first letter of the api name, then concatenate the resource name onto it.
+	// e.g. compute, instance -> ComputeInstance
+	typeName := strings.ToUpper(api[0:1]) + api[1:] + resource
+	required, optional, computed := generateFields(doc.Schemas, resource)
+
+	data := &resourceData{
+		TypeName:     typeName,
+		ResourceName: fmt.Sprintf("google_%s_%s", api, underscore(resource)),
+		Resource:     resource,
+		ReqFields:    required,
+		OptFields:    optional,
+		ComFields:    computed,
+		Fields:       resourceFields(doc.Schemas, resource, typeName, 0),
+
+		CreateUrl:       urlTemplate(doc, methods.Create, false),
+		CreateVerb:      methods.Create.HttpMethod,
+		ReadUrl:         urlTemplate(doc, methods.Get, true),
+		DeleteUrl:       urlTemplate(doc, methods.Delete, true),
+		CreateOperation: returnsOperation(methods.Create),
+		DeleteOperation: returnsOperation(methods.Delete),
+	}
+
+	if p := createIdParam(methods.Create, resource); p != "" {
+		data.CreateUrl += fmt.Sprintf("?%s={{name}}", p)
+	}
+	if methods.Update != nil {
+		data.UpdateUrl = urlTemplate(doc, methods.Update, true)
+		data.UpdateVerb = methods.Update.HttpMethod
+		data.UpdateOperation = returnsOperation(methods.Update)
+	}
+	if methods.List != nil {
+		data.ListUrl = urlTemplate(doc, methods.List, false)
+		data.ListField = listField(doc.Schemas, methods.List, resource)
+	}
+
+	data.IdFormat = idFormat(data.ReadUrl)
+	data.ImportFormats = importFormats(data.ReadUrl)
+
+	properties := make(map[string]bool)
+	for k := range doc.Schemas[resource].Properties {
+		properties[underscore(k)] = true
+	}
+	for _, v := range templateVarRegex.FindAllStringSubmatch(data.ReadUrl, -1) {
+		if !properties[v[1]] {
+			data.UrlFields = append(data.UrlFields, v[1])
+		}
 	}
+
+	return data, nil
 }
 
 func generateFields(jsonSchemas map[string]discovery.JsonSchema, property string) (required, optional, computed map[string]string) {
@@ -177,8 +572,12 @@ func generateField(jsonSchemas map[string]discovery.JsonSchema, field string, v
 	return schemaCode(s, isNested)
 }
 
+func isComputed(v discovery.JsonSchema) bool {
+	return v.ReadOnly || strings.HasPrefix(v.Description, "Output-only") || strings.HasPrefix(v.Description, "[Output Only]")
+}
+
 func setProperties(v discovery.JsonSchema, s *schema.Schema) {
-	if v.ReadOnly || strings.HasPrefix(v.Description, "Output-only") || strings.HasPrefix(v.Description, "[Output Only]") {
+	if isComputed(v) {
 		s.Computed = true
 	} else {
 		if v.Required || strings.HasPrefix(v.Description, "Required") {
@@ -246,9 +645,26 @@ MaxItems: {{.Schema.MaxItems}},{{end}}{{if .Schema.Elem}}
 Elem: {{.Schema.Elem}},{{end}}{{if not .IsNested}}
 {{end}}{{"}"}}`))
 
-var googleTemplate = template.Must(template.New("google").Parse(`package google
+var templateFuncs = template.FuncMap{
+	// Test configs are raw strings, which can't be written inside the raw string templates.
+	"bt": func() string { return "`" },
+	"providerDefault": func(field string) bool {
+		return field == "project" || field == "region" || field == "zone"
+	},
+}
+
+var googleTemplate = template.Must(template.New("google").Funcs(templateFuncs).Parse(`package google
+
+import (
+	"fmt"
+	"log"
+{{- if .UsesReflect}}
+	"reflect"
+{{- end}}
+{{- if .UsesStrconv}}
+	"strconv"
+{{- end}}
 
-import(
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -256,10 +672,13 @@ func resource{{.TypeName}}() *schema.Resource {
 	return &schema.Resource{
 		Create: resource{{.TypeName}}Create,
 		Read:   resource{{.TypeName}}Read,
+{{- if .UpdateUrl}}
 		Update: resource{{.TypeName}}Update,
+{{- end}}
 		Delete: resource{{.TypeName}}Delete,
+
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resource{{.TypeName}}Import,
 		},
 
 		Schema: map[string]*schema.Schema{ {{range $name, $schema := .ReqFields}}
@@ -268,6 +687,17 @@ func resource{{.TypeName}}() *schema.Resource {
 			"{{ $name }}": {{ $schema }},
 {{end}}{{range $name, $schema := .ComFields}}
 			"{{ $name }}": {{ $schema }},
+{{end}}{{range .UrlFields}}
+			"{{.}}": {
+				Type:     schema.TypeString,
+{{- if providerDefault .}}
+				Optional: true,
+				Computed: true,
+{{- else}}
+				Required: true,
+{{- end}}
+				ForceNew: true,
+			},
 {{end}}
 		},
 	}
@@ -276,36 +706,389 @@ func resource{{.TypeName}}() *schema.Resource {
 func resource{{.TypeName}}Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	project, err := getProject(d, config)
+	obj := make(map[string]interface{})
+{{- template "expandObj" .}}
+
+	url, err := replaceVars(d, config, "{{.CreateUrl}}")
 	if err != nil {
 		return err
 	}
+
+	log.Printf("[DEBUG] Creating new {{.Resource}}: %#v", obj)
+	res, err := sendRequest(config, "{{.CreateVerb}}", url, obj)
+	if err != nil {
+		return fmt.Errorf("Error creating {{.Resource}}: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{.IdFormat}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+{{- if .CreateOperation}}
+
+	// TODO: {{.CreateVerb}} returns a long-running operation. Wait for it with the
+	// product's operation waiter, and clear the id if it fails.
+{{- end}}
+
+	log.Printf("[DEBUG] Finished creating {{.Resource}} %q: %#v", d.Id(), res)
+
+	return resource{{.TypeName}}Read(d, meta)
 }
 
 func resource{{.TypeName}}Read(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	url, err := replaceVars(d, config, "{{.ReadUrl}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("{{.TypeName}} %q", d.Id()))
+	}
+{{- range .UrlFields}}{{if eq . "project"}}
+
 	project, err := getProject(d, config)
 	if err != nil {
 		return err
 	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading {{$.Resource}}: %s", err)
+	}
+{{- end}}{{end}}
+{{range .Fields}}
+	if err := d.Set("{{.Name}}", flatten{{.FuncName}}(res["{{.ApiName}}"], d)); err != nil {
+		return fmt.Errorf("Error reading {{$.Resource}}: %s", err)
+	}
+{{- end}}
+
+	return nil
 }
+{{- if .UpdateUrl}}
 
 func resource{{.TypeName}}Update(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	project, err := getProject(d, config)
+	// TODO: remove ForceNew from the fields this method can update, and send
+	// an update mask if the API requires one.
+	obj := make(map[string]interface{})
+{{- template "expandObj" .}}
+
+	url, err := replaceVars(d, config, "{{.UpdateUrl}}")
 	if err != nil {
 		return err
 	}
+
+	log.Printf("[DEBUG] Updating {{.Resource}} %q: %#v", d.Id(), obj)
+	res, err := sendRequest(config, "{{.UpdateVerb}}", url, obj)
+	if err != nil {
+		return fmt.Errorf("Error updating {{.Resource}} %q: %s", d.Id(), err)
+	}
+{{- if .UpdateOperation}}
+
+	// TODO: {{.UpdateVerb}} returns a long-running operation. Wait for it with the
+	// product's operation waiter.
+{{- end}}
+
+	log.Printf("[DEBUG] Finished updating {{.Resource}} %q: %#v", d.Id(), res)
+
+	return resource{{.TypeName}}Read(d, meta)
 }
+{{- end}}
 
 func resource{{.TypeName}}Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	project, err := getProject(d, config)
+	url, err := replaceVars(d, config, "{{.DeleteUrl}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting {{.Resource}} %q", d.Id())
+	res, err := sendRequest(config, "DELETE", url, obj)
+	if err != nil {
+		return handleNotFoundError(err, d, "{{.Resource}}")
+	}
+{{- if .DeleteOperation}}
+
+	// TODO: DELETE returns a long-running operation. Wait for it with the
+	// product's operation waiter.
+{{- end}}
+
+	log.Printf("[DEBUG] Finished deleting {{.Resource}} %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resource{{.TypeName}}Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	parseImportId([]string{ {{- range $i, $f := .ImportFormats}}{{if $i}}, {{end}}"{{$f}}"{{end -}} }, d, config)
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{.IdFormat}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+{{range .Fields}}{{template "flatten" .}}{{end}}
+{{- range .Fields}}{{if not .Computed}}{{template "expand" .}}{{end}}{{end}}
+
+{{- define "expandObj"}}
+{{- range .Fields}}{{if not .Computed}}
+	{{.ApiName}}Prop, err := expand{{.FuncName}}(d.Get("{{.Name}}"), d, config)
 	if err != nil {
 		return err
+	} else if v, ok := d.GetOkExists("{{.Name}}"); !isEmptyValue(reflect.ValueOf({{.ApiName}}Prop)) && (ok || !reflect.DeepEqual(v, {{.ApiName}}Prop)) {
+		obj["{{.ApiName}}"] = {{.ApiName}}Prop
 	}
+{{- end}}{{end}}
+{{- end}}
+
+{{- define "flatten"}}
+
+func flatten{{.FuncName}}(v interface{}, d *schema.ResourceData) interface{} {
+{{- if and .IsObject (eq .Type "array")}}
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+{{- range .Fields}}
+			"{{.Name}}": flatten{{.FuncName}}(original["{{.ApiName}}"], d),
+{{- end}}
+		})
+	}
+	return transformed
+{{- else if .IsObject}}
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+{{- range .Fields}}
+	transformed["{{.Name}}"] =
+		flatten{{.FuncName}}(original["{{.ApiName}}"], d)
+{{- end}}
+	return []interface{}{transformed}
+{{- else if eq .Type "integer"}}
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+{{- else if eq .Name "name"}}
+	if v == nil {
+		return v
+	}
+	return NameFromSelfLinkStateFunc(v)
+{{- else}}
+	return v
+{{- end}}
+}
+{{- range .Fields}}{{template "flatten" .}}{{end}}
+{{- end}}
+
+{{- define "expandFields"}}
+{{- range .Fields}}{{if not .Computed}}
+
+	transformed{{.GoName}}, err := expand{{.FuncName}}(original["{{.Name}}"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformed{{.GoName}}); val.IsValid() && !isEmptyValue(val) {
+		transformed["{{.ApiName}}"] = transformed{{.GoName}}
+	}
+{{- end}}{{end}}
+{{- end}}
+
+{{- define "expand"}}
+
+func expand{{.FuncName}}(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+{{- if and .IsObject (eq .Type "array")}}
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+{{- template "expandFields" .}}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+{{- else if .IsObject}}
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+{{- template "expandFields" .}}
+
+	return transformed, nil
+{{- else if eq .Type "object"}}
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+{{- else}}
+	return v, nil
+{{- end}}
+}
+{{- range .Fields}}{{if not .Computed}}{{template "expand" .}}{{end}}{{end}}
+{{- end}}
+`))
+
+var testTemplate = template.Must(template.New("test").Funcs(templateFuncs).Parse(`package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+{{- if .ListField}}
+
+func init() {
+	resource.AddTestSweepers("gcp_{{.ResourceName}}", &resource.Sweeper{
+		Name: "gcp_{{.ResourceName}}",
+		F:    testSweep{{.TypeName}},
+	})
+}
+{{- end}}
+
+func TestAcc{{.TypeName}}_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheck{{.TypeName}}Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAcc{{.TypeName}}_basic(name),
+			},
+			{
+				ResourceName:      "{{.ResourceName}}.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheck{{.TypeName}}Destroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "{{.ResourceName}}" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "{{.ReadUrl}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("{{.TypeName}} still exists at %s", url)
+		}
+	}
+
+	return nil
+}
+{{- if .ListField}}
+
+func testSweep{{.TypeName}}(region string) error {
+	config, err := sharedConfigForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting shared config for region: %s", err)
+	}
+
+	err = config.loadAndValidate()
+	if err != nil {
+		log.Fatalf("error loading: %s", err)
+	}
+
+	// Sweepers run against a region, so zonal resources are swept in its "-a" zone.
+	listUrl := strings.NewReplacer(
+		"{{"{{"}}project{{"}}"}}", config.Project,
+		"{{"{{"}}region{{"}}"}}", region,
+		"{{"{{"}}location{{"}}"}}", region,
+		"{{"{{"}}zone{{"}}"}}", region+"-a",
+	).Replace("{{.ListUrl}}")
+
+	res, err := sendRequest(config, "GET", listUrl, nil)
+	if err != nil {
+		return fmt.Errorf("error listing {{.ResourceName}}: %s", err)
+	}
+
+	items, ok := res["{{.ListField}}"].([]interface{})
+	if !ok {
+		log.Printf("[INFO] Nothing found in {{.ResourceName}} list response.")
+		return nil
+	}
+
+	for _, item := range items {
+		obj := item.(map[string]interface{})
+		name := GetResourceNameFromSelfLink(obj["name"].(string))
+		if !strings.HasPrefix(name, "tf-test") {
+			continue
+		}
+
+		deleteUrl := listUrl + "/" + name
+		if _, err := sendRequest(config, "DELETE", deleteUrl, nil); err != nil {
+			log.Printf("[WARNING] Error deleting {{.ResourceName}} %q: %s", name, err)
+		} else {
+			log.Printf("[INFO] Sent delete request for {{.ResourceName}} %q", name)
+		}
+	}
+
+	return nil
+}
+{{- end}}
+
+func testAcc{{.TypeName}}_basic(name string) string {
+	return fmt.Sprintf({{bt}}
+resource "{{.ResourceName}}" "foobar" {
+  name = "%s"
+  # TODO: set the remaining required fields.
+}
+{{bt}}, name)
 }
 `))
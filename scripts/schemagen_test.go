@@ -1,6 +1,8 @@
 package main
 
 import (
+	"io/ioutil"
+	"os"
 	"reflect"
 	"testing"
 
@@ -199,3 +201,154 @@ func TestUnderscore(t *testing.T) {
 		}
 	}
 }
+
+func TestUrlTemplate(t *testing.T) {
+	cases := map[string]struct {
+		Doc      *discovery.RestDescription
+		Method   *discovery.RestMethod
+		NameLast bool
+		Expected string
+	}{
+		"simple path params": {
+			Doc: &discovery.RestDescription{
+				RootUrl:     "https://www.googleapis.com/",
+				ServicePath: "compute/v1/projects/",
+			},
+			Method: &discovery.RestMethod{
+				Path: "{project}/zones/{zone}/disks/{disk}",
+			},
+			NameLast: true,
+			Expected: "https://www.googleapis.com/compute/v1/projects/{{project}}/zones/{{zone}}/disks/{{name}}",
+		},
+		"relative resource name": {
+			Doc: &discovery.RestDescription{
+				RootUrl: "https://redis.googleapis.com/",
+			},
+			Method: &discovery.RestMethod{
+				Path: "v1beta1/{+name}",
+				Parameters: map[string]discovery.JsonSchema{
+					"name": {Location: "path", Pattern: "^projects/[^/]+/locations/[^/]+/instances/[^/]+$"},
+				},
+			},
+			NameLast: true,
+			Expected: "https://redis.googleapis.com/v1beta1/projects/{{project}}/locations/{{location}}/instances/{{name}}",
+		},
+		"relative parent name": {
+			Doc: &discovery.RestDescription{
+				RootUrl: "https://redis.googleapis.com/",
+			},
+			Method: &discovery.RestMethod{
+				Path: "v1beta1/{+parent}/instances",
+				Parameters: map[string]discovery.JsonSchema{
+					"parent": {Location: "path", Pattern: "^projects/[^/]+/locations/[^/]+$"},
+				},
+			},
+			Expected: "https://redis.googleapis.com/v1beta1/projects/{{project}}/locations/{{location}}/instances",
+		},
+		"collection ids": {
+			Doc: &discovery.RestDescription{
+				RootUrl: "https://example.googleapis.com/",
+			},
+			Method: &discovery.RestMethod{
+				Path: "v1/projects/{projectsId}/locations/{locationsId}/policies/{policiesId}",
+			},
+			NameLast: true,
+			Expected: "https://example.googleapis.com/v1/projects/{{project}}/locations/{{location}}/policies/{{name}}",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := urlTemplate(tc.Doc, tc.Method, tc.NameLast); got != tc.Expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestImportFormats(t *testing.T) {
+	cases := map[string][]string{
+		"https://www.googleapis.com/compute/v1/projects/{{project}}/zones/{{zone}}/disks/{{name}}": {
+			"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/disks/(?P<name>[^/]+)",
+			"(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)",
+			"(?P<name>[^/]+)",
+		},
+		"https://storage.googleapis.com/storage/v1/b/{{name}}": {
+			"(?P<name>[^/]+)",
+		},
+	}
+
+	for url, expected := range cases {
+		if got := importFormats(url); !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: expected %q, got %q", url, expected, got)
+		}
+	}
+}
+
+func TestFindResourceMethods(t *testing.T) {
+	resources := map[string]discovery.RestResource{
+		"projects": {
+			Resources: map[string]discovery.RestResource{
+				"topics": {
+					Methods: map[string]discovery.RestMethod{
+						"get":    {Id: "topics.get", Response: &discovery.RestMethodResponse{Ref: "Topic"}},
+						"create": {Id: "topics.create", Response: &discovery.RestMethodResponse{Ref: "Topic"}},
+						"delete": {Id: "topics.delete"},
+					},
+				},
+				"subscriptions": {
+					Methods: map[string]discovery.RestMethod{
+						"get":    {Id: "subscriptions.get", Response: &discovery.RestMethodResponse{Ref: "Subscription"}},
+						"create": {Id: "subscriptions.create", Response: &discovery.RestMethodResponse{Ref: "Subscription"}},
+						"patch":  {Id: "subscriptions.patch", Response: &discovery.RestMethodResponse{Ref: "Subscription"}},
+						"delete": {Id: "subscriptions.delete"},
+						"list":   {Id: "subscriptions.list"},
+					},
+				},
+			},
+		},
+	}
+
+	m := findResourceMethods(resources, "Subscription")
+	if m == nil {
+		t.Fatal("Expected to find the Subscription methods")
+	}
+	if m.Create.Id != "subscriptions.create" || m.Update.Id != "subscriptions.patch" || m.Delete.Id != "subscriptions.delete" || m.List.Id != "subscriptions.list" {
+		t.Errorf("Found the wrong methods for Subscription: %+v", m)
+	}
+
+	m = findResourceMethods(resources, "Topic")
+	if m == nil {
+		t.Fatal("Expected to find the Topic methods")
+	}
+	if m.Update != nil || m.List != nil {
+		t.Errorf("Expected Topic to have no update or list method, got %+v", m)
+	}
+
+	if findResourceMethods(resources, "Snapshot") != nil {
+		t.Error("Expected no methods for Snapshot")
+	}
+}
+
+func TestLoadDiscoveryDocument(t *testing.T) {
+	f, err := ioutil.TempFile("", "discovery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+
+	doc := `{"name": "redis", "rootUrl": "https://redis.googleapis.com/", "schemas": {"Instance": {"type": "object", "properties": {"name": {"type": "string"}}}}}`
+	if _, err := f.WriteString(doc); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	loaded, err := loadDiscoveryDocument(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.RootUrl != "https://redis.googleapis.com/" {
+		t.Errorf("Expected rootUrl to be read, got %q", loaded.RootUrl)
+	}
+	if _, ok := loaded.Schemas["Instance"].Properties["name"]; !ok {
+		t.Errorf("Expected the Instance schema to be read, got %+v", loaded.Schemas)
+	}
+}